@@ -0,0 +1,237 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/andygrunwald/go-jira"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+)
+
+// dataSourceIssue is used to look up an existing JIRA issue
+func dataSourceIssue() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIssueRead,
+
+		Schema: map[string]*schema.Schema{
+			"key": {
+				Description: "The key or ID of the issue.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"fields": {
+				Description: "The fields to fetch. Defaults to all fields.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"expand": {
+				Description: "The sections to expand, e.g. renderedFields or names.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			// Computed values
+			"issue_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"issue_key": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"project_key": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"issue_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"summary": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status_category": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"assignee_account_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"reporter_account_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"labels": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"components": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"fix_versions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"affects_versions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"parent": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"subtasks": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"links": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"link_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"direction": {
+							Description: "inward or outward, seen from this issue.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"issue_key": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"fields_json": {
+				Description: "All custom and non-standard fields of the issue as JSON.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceIssueRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*Config)
+	key := d.Get("key").(string)
+
+	options := &jira.GetQueryOptions{
+		Fields: strings.Join(stringList(d.Get("fields")), ","),
+		Expand: strings.Join(stringList(d.Get("expand")), ","),
+	}
+
+	issue, res, err := config.jiraClient.Issue.GetWithContext(ctx, key, options)
+	if err != nil {
+		if isNotFound(res) {
+			return diag.Errorf("jira issue %s not found", key)
+		}
+		return diag.FromErr(errors.Wrap(err, "getting jira issue failed"))
+	}
+
+	d.SetId(issue.ID)
+	d.Set("issue_id", issue.ID)
+	d.Set("issue_key", issue.Key)
+	d.Set("project_key", issue.Fields.Project.Key)
+	d.Set("issue_type", issue.Fields.Type.Name)
+	d.Set("summary", issue.Fields.Summary)
+	d.Set("description", issue.Fields.Description)
+	d.Set("labels", issue.Fields.Labels)
+
+	if issue.Fields.Status != nil {
+		d.Set("status_id", issue.Fields.Status.ID)
+		d.Set("status_name", issue.Fields.Status.Name)
+		d.Set("status_category", issue.Fields.Status.StatusCategory.Key)
+	}
+
+	if issue.Fields.Assignee != nil {
+		d.Set("assignee_account_id", issue.Fields.Assignee.AccountID)
+	}
+
+	if issue.Fields.Reporter != nil {
+		d.Set("reporter_account_id", issue.Fields.Reporter.AccountID)
+	}
+
+	if issue.Fields.Parent != nil {
+		d.Set("parent", issue.Fields.Parent.Key)
+	}
+
+	components := []string{}
+	for _, component := range issue.Fields.Components {
+		components = append(components, component.Name)
+	}
+	d.Set("components", components)
+
+	fixVersions := []string{}
+	for _, version := range issue.Fields.FixVersions {
+		fixVersions = append(fixVersions, version.Name)
+	}
+	d.Set("fix_versions", fixVersions)
+
+	affectsVersions := []string{}
+	for _, version := range issue.Fields.AffectsVersions {
+		affectsVersions = append(affectsVersions, version.Name)
+	}
+	d.Set("affects_versions", affectsVersions)
+
+	subtasks := []string{}
+	for _, subtask := range issue.Fields.Subtasks {
+		subtasks = append(subtasks, subtask.Key)
+	}
+	d.Set("subtasks", subtasks)
+
+	links := []interface{}{}
+	for _, link := range issue.Fields.IssueLinks {
+		l := map[string]interface{}{
+			"id":        link.ID,
+			"link_type": link.Type.Name,
+		}
+		if link.InwardIssue != nil {
+			l["direction"] = "inward"
+			l["issue_key"] = link.InwardIssue.Key
+		} else if link.OutwardIssue != nil {
+			l["direction"] = "outward"
+			l["issue_key"] = link.OutwardIssue.Key
+		}
+		links = append(links, l)
+	}
+	d.Set("links", links)
+
+	fieldsJSON, err := json.Marshal(issue.Fields.Unknowns)
+	if err != nil {
+		return diag.FromErr(errors.Wrap(err, "encoding issue fields failed"))
+	}
+	d.Set("fields_json", string(fieldsJSON))
+
+	return nil
+}
@@ -0,0 +1,253 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/pkg/errors"
+)
+
+// jiraTimeLayout is the format JIRA uses for timestamps
+const jiraTimeLayout = "2006-01-02T15:04:05.000-0700"
+
+// changelogPageSize is the number of changelog entries fetched per request
+const changelogPageSize = 100
+
+func issueChangelogAPIEndpoint(issueKey string) string {
+	return fmt.Sprintf("/rest/api/2/issue/%s/changelog", issueKey)
+}
+
+// ChangelogItem is a single field change within a changelog entry
+type ChangelogItem struct {
+	Field      string `json:"field"`
+	FieldID    string `json:"fieldId"`
+	From       string `json:"from"`
+	FromString string `json:"fromString"`
+	To         string `json:"to"`
+	ToString   string `json:"toString"`
+}
+
+// ChangelogEntry groups the fields changed by one user at the same time
+type ChangelogEntry struct {
+	ID     string `json:"id"`
+	Author struct {
+		AccountID   string `json:"accountId"`
+		Name        string `json:"name"`
+		DisplayName string `json:"displayName"`
+	} `json:"author"`
+	Created string          `json:"created"`
+	Items   []ChangelogItem `json:"items"`
+}
+
+// ChangelogResponse is a page of changelog entries returned by JIRA
+type ChangelogResponse struct {
+	StartAt    int              `json:"startAt"`
+	MaxResults int              `json:"maxResults"`
+	Total      int              `json:"total"`
+	IsLast     bool             `json:"isLast"`
+	Values     []ChangelogEntry `json:"values"`
+}
+
+// dataSourceIssueChangelog is used to look up the change history of a JIRA issue
+func dataSourceIssueChangelog() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIssueChangelogRead,
+
+		Schema: map[string]*schema.Schema{
+			"key": {
+				Description: "The key or ID of the issue.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"fields": {
+				Description: "Only return changes to these fields, by name or ID.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"since": {
+				Description:  "Only return changes made at or after this RFC 3339 timestamp.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"until": {
+				Description:  "Only return changes made before this RFC 3339 timestamp.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			// Computed values
+			"entries": {
+				Description: "One entry per changed field, oldest first.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"author_account_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"author_display_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"created": {
+							Description: "When the change was made, as RFC 3339 timestamp.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"field": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"field_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"from": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"from_string": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"to": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"to_string": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// getIssueChangelog returns all changelog entries of an issue. Instances
+// without the paginated changelog endpoint return the changelog expanded on
+// the issue instead.
+func getIssueChangelog(ctx context.Context, config *Config, issueKey string) ([]ChangelogEntry, error) {
+	entries := []ChangelogEntry{}
+	startAt := 0
+
+	for {
+		page := new(ChangelogResponse)
+		endpoint := fmt.Sprintf("%s?startAt=%d&maxResults=%d", issueChangelogAPIEndpoint(issueKey), startAt, changelogPageSize)
+
+		res, err := requestWithContext(ctx, config.jiraClient, "GET", endpoint, nil, page)
+		if err != nil {
+			if isNotFound(res) && startAt == 0 {
+				return getExpandedIssueChangelog(ctx, config, issueKey)
+			}
+			return nil, errors.Wrap(err, "getting jira issue changelog failed")
+		}
+
+		entries = append(entries, page.Values...)
+		startAt += len(page.Values)
+
+		if page.IsLast || len(page.Values) == 0 || startAt >= page.Total {
+			return entries, nil
+		}
+	}
+}
+
+// getExpandedIssueChangelog returns the changelog embedded in the issue
+func getExpandedIssueChangelog(ctx context.Context, config *Config, issueKey string) ([]ChangelogEntry, error) {
+	issue := new(struct {
+		Changelog struct {
+			Histories []ChangelogEntry `json:"histories"`
+		} `json:"changelog"`
+	})
+
+	endpoint := fmt.Sprintf("/rest/api/2/issue/%s?fields=none&expand=changelog", issueKey)
+	_, err := requestWithContext(ctx, config.jiraClient, "GET", endpoint, nil, issue)
+	if err != nil {
+		return nil, errors.Wrap(err, "getting jira issue changelog failed")
+	}
+
+	return issue.Changelog.Histories, nil
+}
+
+func dataSourceIssueChangelogRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*Config)
+	key := d.Get("key").(string)
+	fields := stringList(d.Get("fields"))
+
+	var since, until time.Time
+	if v, ok := d.GetOk("since"); ok {
+		since, _ = time.Parse(time.RFC3339, v.(string))
+	}
+	if v, ok := d.GetOk("until"); ok {
+		until, _ = time.Parse(time.RFC3339, v.(string))
+	}
+
+	changelog, err := getIssueChangelog(ctx, config, key)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	entries := []interface{}{}
+	for _, entry := range changelog {
+		created, err := time.Parse(jiraTimeLayout, entry.Created)
+		if err != nil {
+			return diag.FromErr(errors.Wrapf(err, "parsing time of changelog entry %s failed", entry.ID))
+		}
+
+		if (!since.IsZero() && created.Before(since)) || (!until.IsZero() && !created.Before(until)) {
+			continue
+		}
+
+		author := entry.Author.AccountID
+		if author == "" {
+			author = entry.Author.Name
+		}
+
+		for _, item := range entry.Items {
+			if len(fields) > 0 && !changelogItemMatches(item, fields) {
+				continue
+			}
+
+			entries = append(entries, map[string]interface{}{
+				"id":                  entry.ID,
+				"author_account_id":   author,
+				"author_display_name": entry.Author.DisplayName,
+				"created":             created.Format(time.RFC3339),
+				"field":               item.Field,
+				"field_id":            item.FieldID,
+				"from":                item.From,
+				"from_string":         item.FromString,
+				"to":                  item.To,
+				"to_string":           item.ToString,
+			})
+		}
+	}
+
+	d.SetId(key)
+	d.Set("entries", entries)
+
+	return nil
+}
+
+// changelogItemMatches reports whether the item changed one of the fields
+func changelogItemMatches(item ChangelogItem, fields []string) bool {
+	for _, field := range fields {
+		if strings.EqualFold(item.Field, field) || item.FieldID == field {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,251 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/andygrunwald/go-jira"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+)
+
+const createMetaAPIEndpoint = "/rest/api/2/issue/createmeta"
+
+// createMetaPageSize is the number of issue types or fields fetched per request
+const createMetaPageSize = 50
+
+// MetaField describes a field on the create or edit screen of an issue
+type MetaField struct {
+	FieldID  string `json:"fieldId"`
+	Required bool   `json:"required"`
+	Name     string `json:"name"`
+	Key      string `json:"key"`
+	Schema   struct {
+		Type   string `json:"type"`
+		Items  string `json:"items"`
+		System string `json:"system"`
+		Custom string `json:"custom"`
+	} `json:"schema"`
+	AllowedValues   []json.RawMessage `json:"allowedValues"`
+	HasDefaultValue bool              `json:"hasDefaultValue"`
+}
+
+// CreateMetaIssueType is an issue type which can be created in a project
+type CreateMetaIssueType struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// CreateMetaIssueTypesResponse is a page of the issue types of a project. JIRA
+// Cloud returns them as issueTypes, Data Center as values.
+type CreateMetaIssueTypesResponse struct {
+	StartAt    int                   `json:"startAt"`
+	MaxResults int                   `json:"maxResults"`
+	Total      int                   `json:"total"`
+	IssueTypes []CreateMetaIssueType `json:"issueTypes"`
+	Values     []CreateMetaIssueType `json:"values"`
+}
+
+// CreateMetaFieldsResponse is a page of the fields of an issue type. JIRA
+// Cloud returns them as fields, Data Center as values.
+type CreateMetaFieldsResponse struct {
+	StartAt    int         `json:"startAt"`
+	MaxResults int         `json:"maxResults"`
+	Total      int         `json:"total"`
+	Fields     []MetaField `json:"fields"`
+	Values     []MetaField `json:"values"`
+}
+
+// getCreateMetaIssueTypes returns the issue types which can be created in a project
+func getCreateMetaIssueTypes(ctx context.Context, config *Config, projectKey string) ([]CreateMetaIssueType, *jira.Response, error) {
+	issueTypes := []CreateMetaIssueType{}
+
+	for startAt := 0; ; {
+		page := new(CreateMetaIssueTypesResponse)
+		urlStr := fmt.Sprintf("%s/%s/issuetypes?startAt=%d&maxResults=%d", createMetaAPIEndpoint, url.PathEscape(projectKey), startAt, createMetaPageSize)
+		res, err := requestWithContext(ctx, config.jiraClient, "GET", urlStr, nil, page)
+		if err != nil {
+			return nil, res, errors.Wrap(err, "getting create meta issue types failed")
+		}
+
+		values := append(page.IssueTypes, page.Values...)
+		issueTypes = append(issueTypes, values...)
+		startAt += len(values)
+
+		if len(values) == 0 || startAt >= page.Total {
+			return issueTypes, res, nil
+		}
+	}
+}
+
+// getCreateMetaFields returns the fields on the create screen of an issue
+// type in a project, keyed by field ID. The response tells whether the
+// project could not be found.
+func getCreateMetaFields(ctx context.Context, config *Config, projectKey string, issueType string) (map[string]MetaField, *jira.Response, error) {
+	issueTypes, res, err := getCreateMetaIssueTypes(ctx, config, projectKey)
+	if err != nil {
+		if isNotFound(res) {
+			return nil, res, fmt.Errorf("project %s does not exist or you cannot create issues in it", projectKey)
+		}
+		return nil, res, err
+	}
+
+	issueTypeID := ""
+	available := []string{}
+	for _, metaIssueType := range issueTypes {
+		if metaIssueType.ID == issueType || strings.EqualFold(metaIssueType.Name, issueType) {
+			issueTypeID = metaIssueType.ID
+			break
+		}
+		available = append(available, metaIssueType.Name)
+	}
+
+	if issueTypeID == "" {
+		return nil, res, fmt.Errorf("issue type %q does not exist in project %s, available issue types: %s", issueType, projectKey, strings.Join(available, ", "))
+	}
+
+	fields := map[string]MetaField{}
+
+	for startAt := 0; ; {
+		page := new(CreateMetaFieldsResponse)
+		urlStr := fmt.Sprintf("%s/%s/issuetypes/%s?startAt=%d&maxResults=%d", createMetaAPIEndpoint, url.PathEscape(projectKey), issueTypeID, startAt, createMetaPageSize)
+		res, err = requestWithContext(ctx, config.jiraClient, "GET", urlStr, nil, page)
+		if err != nil {
+			return nil, res, errors.Wrap(err, "getting create meta fields failed")
+		}
+
+		values := append(page.Fields, page.Values...)
+		for _, field := range values {
+			id := field.FieldID
+			if id == "" {
+				id = field.Key
+			}
+			fields[id] = field
+		}
+		startAt += len(values)
+
+		if len(values) == 0 || startAt >= page.Total {
+			return fields, res, nil
+		}
+	}
+}
+
+// allowedValueLabels returns the labels of the values a field accepts
+func allowedValueLabels(field MetaField) []string {
+	labels := []string{}
+	for _, raw := range field.AllowedValues {
+		var value interface{}
+		if json.Unmarshal(raw, &value) == nil {
+			labels = append(labels, fieldValueLabel(value))
+		}
+	}
+	return labels
+}
+
+// dataSourceIssueCreateMeta is used to look up the fields needed to create a JIRA issue
+func dataSourceIssueCreateMeta() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIssueCreateMetaRead,
+
+		Schema: map[string]*schema.Schema{
+			"project_key": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"issue_type": {
+				Description: "Name or ID of the issue type.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			// Computed values
+			"required_fields": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"fields": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"required": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"has_default_value": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"items": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"allowed_values": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIssueCreateMetaRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*Config)
+	projectKey := d.Get("project_key").(string)
+	issueType := d.Get("issue_type").(string)
+
+	metaFields, _, err := getCreateMetaFields(ctx, config, projectKey, issueType)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ids := []string{}
+	for id := range metaFields {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	requiredFields := []string{}
+	fields := []interface{}{}
+	for _, id := range ids {
+		field := metaFields[id]
+		if field.Required {
+			requiredFields = append(requiredFields, id)
+		}
+		fields = append(fields, map[string]interface{}{
+			"id":                id,
+			"name":              field.Name,
+			"required":          field.Required,
+			"has_default_value": field.HasDefaultValue,
+			"type":              field.Schema.Type,
+			"items":             field.Schema.Items,
+			"allowed_values":    allowedValueLabels(field),
+		})
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", projectKey, issueType))
+	d.Set("required_fields", requiredFields)
+	d.Set("fields", fields)
+
+	return nil
+}
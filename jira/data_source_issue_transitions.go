@@ -0,0 +1,89 @@
+package jira
+
+import (
+	"context"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+)
+
+// dataSourceIssueTransitions is used to list the transitions available for a JIRA issue
+func dataSourceIssueTransitions() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIssueTransitionsRead,
+
+		Schema: map[string]*schema.Schema{
+			"issue_key": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			// Computed values
+			"transitions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"to_status_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"to_status_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"required_fields": {
+							Description: "IDs of the fields which must be set when performing the transition.",
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIssueTransitionsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*Config)
+	issueKey := d.Get("issue_key").(string)
+
+	transitions, _, err := config.jiraClient.Issue.GetTransitionsWithContext(ctx, issueKey)
+	if err != nil {
+		return diag.FromErr(errors.Wrap(err, "getting transitions of jira issue failed"))
+	}
+
+	result := []interface{}{}
+	for _, transition := range transitions {
+		requiredFields := []string{}
+		for field, meta := range transition.Fields {
+			if meta.Required {
+				requiredFields = append(requiredFields, field)
+			}
+		}
+		sort.Strings(requiredFields)
+
+		result = append(result, map[string]interface{}{
+			"id":              transition.ID,
+			"name":            transition.Name,
+			"to_status_id":    transition.To.ID,
+			"to_status_name":  transition.To.Name,
+			"required_fields": requiredFields,
+		})
+	}
+
+	d.SetId(issueKey)
+	d.Set("transitions", result)
+
+	return nil
+}
@@ -0,0 +1,149 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+)
+
+// noValueLabel is used to count issues whose group_by field is empty
+const noValueLabel = "none"
+
+// dataSourceJQLCount is used to count the issues matching a JQL query
+func dataSourceJQLCount() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceJQLCountRead,
+
+		Schema: map[string]*schema.Schema{
+			"jql": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"group_by": {
+				Description: "Field to group the counts by, e.g. status, priority, assignee or a custom field ID.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"validate": {
+				Description: "Validate the JQL before counting.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
+			// Computed values
+			"total": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"counts": {
+				Description: "Number of issues per value of the group_by field. Issues without a value are counted as \"none\".",
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+			},
+		},
+	}
+}
+
+func dataSourceJQLCountRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*Config)
+	jql := d.Get("jql").(string)
+	groupBy := d.Get("group_by").(string)
+
+	if d.Get("validate").(bool) {
+		if diags := jqlDiagnostics(ctx, config, jql); diags.HasError() {
+			return diags
+		}
+	}
+
+	counts := map[string]int{}
+	var total int
+	var err error
+
+	if groupBy == "" {
+		total, err = countIssues(ctx, config, jql)
+	} else {
+		handler := func(i SearchIssue) error {
+			for _, label := range fieldValueLabels(i.Fields[groupBy]) {
+				counts[label]++
+			}
+			return nil
+		}
+		total, err = searchIssues(ctx, config, jql, []string{groupBy}, 0, handler)
+	}
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", jql, groupBy))
+	d.Set("total", total)
+	d.Set("counts", counts)
+
+	return nil
+}
+
+// countIssues returns the number of issues matching the query without
+// fetching any of them
+func countIssues(ctx context.Context, config *Config, jql string) (int, error) {
+	search := SearchRequest{
+		JQL:        jql,
+		MaxResults: 0,
+		Fields:     []string{"id"},
+	}
+
+	result := new(SearchResponse)
+	_, err := requestWithContext(ctx, config.jiraClient, "POST", searchAPIEndpoint, search, result)
+	if err != nil {
+		return 0, errors.Wrap(err, "searching jira issue failed")
+	}
+
+	return result.Total, nil
+}
+
+// fieldValueLabels returns the labels an issue is counted under for a field
+// value. Objects are labeled by their name, multi-value fields count once per
+// element.
+func fieldValueLabels(raw json.RawMessage) []string {
+	var value interface{}
+	if len(raw) == 0 || json.Unmarshal(raw, &value) != nil {
+		return []string{noValueLabel}
+	}
+
+	switch v := value.(type) {
+	case []interface{}:
+		if len(v) == 0 {
+			return []string{noValueLabel}
+		}
+		labels := []string{}
+		for _, element := range v {
+			labels = append(labels, fieldValueLabel(element))
+		}
+		return labels
+	}
+
+	return []string{fieldValueLabel(value)}
+}
+
+func fieldValueLabel(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return noValueLabel
+	case string:
+		return v
+	case map[string]interface{}:
+		for _, key := range []string{"name", "displayName", "value", "key", "id"} {
+			if label, ok := v[key]; ok && label != nil {
+				return fmt.Sprintf("%v", label)
+			}
+		}
+		encoded, _ := json.Marshal(v)
+		return string(encoded)
+	}
+
+	return fmt.Sprintf("%v", value)
+}
@@ -0,0 +1,32 @@
+package jira
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestFieldValueLabels(t *testing.T) {
+	cases := []struct {
+		raw      string
+		expected []string
+	}{
+		{``, []string{"none"}},
+		{`null`, []string{"none"}},
+		{`"text"`, []string{"text"}},
+		{`3`, []string{"3"}},
+		{`{"name": "Open", "id": "1"}`, []string{"Open"}},
+		{`{"displayName": "Jane Doe", "accountId": "abc"}`, []string{"Jane Doe"}},
+		{`{"value": "Red", "id": "10001"}`, []string{"Red"}},
+		{`[]`, []string{"none"}},
+		{`["a", "b"]`, []string{"a", "b"}},
+		{`[{"name": "API"}, {"name": "UI"}]`, []string{"API", "UI"}},
+	}
+
+	for _, c := range cases {
+		labels := fieldValueLabels(json.RawMessage(c.raw))
+		if !reflect.DeepEqual(labels, c.expected) {
+			t.Errorf("fieldValueLabels(%q) = %v, expected %v", c.raw, labels, c.expected)
+		}
+	}
+}
@@ -0,0 +1,217 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+)
+
+// dataSourceProject is used to look up an existing JIRA project
+func dataSourceProject() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceProjectRead,
+
+		Schema: map[string]*schema.Schema{
+			"key": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"key", "project_id"},
+			},
+			"project_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"key", "project_id"},
+			},
+			// Computed values
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"lead_account_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"assignee_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"category_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"category_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"project_type_key": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"style": {
+				Description: "classic or team_managed.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"issue_security_scheme": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"notification_scheme": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"permission_scheme": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"issue_types": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"subtask": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"components": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"versions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"released": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"archived": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceProjectRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*Config)
+
+	projectIDOrKey := d.Get("key").(string)
+	if projectID, ok := d.GetOk("project_id"); ok {
+		projectIDOrKey = projectID.(string)
+	}
+
+	project, res, err := getProject(ctx, config, projectIDOrKey)
+	if err != nil {
+		if isNotFound(res) {
+			return diag.Errorf("jira project %s not found", projectIDOrKey)
+		}
+		return diag.FromErr(err)
+	}
+
+	d.SetId(project.ID)
+	d.Set("key", project.Key)
+	d.Set("project_id", project.ID)
+	d.Set("name", project.Name)
+	d.Set("description", project.Description)
+	d.Set("url", project.URL)
+	d.Set("lead_account_id", project.Lead.AccountID)
+	d.Set("assignee_type", project.AssigneeType)
+	d.Set("category_id", project.ProjectCategory.ID)
+	d.Set("category_name", project.ProjectCategory.Name)
+	d.Set("project_type_key", project.ProjectTypeKey)
+	d.Set("style", projectStyle(project))
+
+	schemes := map[string]string{
+		"issue_security_scheme": "issuesecuritylevelscheme",
+		"notification_scheme":   "notificationscheme",
+		"permission_scheme":     "permissionscheme",
+	}
+	for attribute, scheme := range schemes {
+		schemeID, err := GetJiraResourceID(config.jiraClient, fmt.Sprintf("%s/%s/%s", projectAPIEndpoint, project.ID, scheme))
+		if err != nil {
+			return diag.FromErr(errors.Wrapf(err, "getting %s failed", scheme))
+		}
+		d.Set(attribute, schemeID)
+	}
+
+	issueTypes := []interface{}{}
+	for _, issueType := range project.IssueTypes {
+		issueTypes = append(issueTypes, map[string]interface{}{
+			"id":      issueType.ID,
+			"name":    issueType.Name,
+			"subtask": issueType.Subtask,
+		})
+	}
+	d.Set("issue_types", issueTypes)
+
+	components := []interface{}{}
+	for _, component := range project.Components {
+		components = append(components, map[string]interface{}{
+			"id":   component.ID,
+			"name": component.Name,
+		})
+	}
+	d.Set("components", components)
+
+	versions := []interface{}{}
+	for _, version := range project.Versions {
+		versions = append(versions, map[string]interface{}{
+			"id":       version.ID,
+			"name":     version.Name,
+			"released": version.Released,
+			"archived": version.Archived,
+		})
+	}
+	d.Set("versions", versions)
+
+	return nil
+}
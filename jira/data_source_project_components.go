@@ -0,0 +1,80 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+)
+
+// dataSourceProjectComponents is used to list the components of a JIRA project
+func dataSourceProjectComponents() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceProjectComponentsRead,
+
+		Schema: map[string]*schema.Schema{
+			"project_key": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			// Computed values
+			"components": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"lead_account_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"assignee_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceProjectComponentsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*Config)
+	projectKey := d.Get("project_key").(string)
+
+	var projectComponents []Component
+	_, err := requestWithContext(ctx, config.jiraClient, "GET", fmt.Sprintf("%s/%s/components", projectAPIEndpoint, projectKey), nil, &projectComponents)
+	if err != nil {
+		return diag.FromErr(errors.Wrap(err, "getting jira components failed"))
+	}
+
+	components := []interface{}{}
+	for _, component := range projectComponents {
+		components = append(components, map[string]interface{}{
+			"id":              component.ID,
+			"name":            component.Name,
+			"description":     component.Description,
+			"lead_account_id": component.Lead.AccountID,
+			"assignee_type":   component.AssigneeType,
+		})
+	}
+
+	d.SetId(projectKey)
+	d.Set("components", components)
+
+	return nil
+}
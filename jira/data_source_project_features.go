@@ -0,0 +1,94 @@
+package jira
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// dataSourceProjectFeatures is used to list the features of a JIRA project
+func dataSourceProjectFeatures() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceProjectFeaturesRead,
+
+		Schema: map[string]*schema.Schema{
+			"project_key": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			// Computed values
+			"features": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"state": {
+							Description: "ENABLED, DISABLED or COMING_SOON.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"locked": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"prerequisites": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"enabled_features": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceProjectFeaturesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*Config)
+	projectKey := d.Get("project_key").(string)
+
+	projectFeatures, _, err := getProjectFeatures(ctx, config, projectKey)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	features := []interface{}{}
+	enabled := []string{}
+	for _, feature := range projectFeatures {
+		features = append(features, map[string]interface{}{
+			"key":           feature.Feature,
+			"name":          feature.LocalisedName,
+			"description":   feature.LocalisedDescription,
+			"state":         feature.State,
+			"locked":        feature.ToggleLocked,
+			"prerequisites": feature.Prerequisites,
+		})
+		if feature.State == "ENABLED" {
+			enabled = append(enabled, feature.Feature)
+		}
+	}
+
+	d.SetId(projectKey)
+	d.Set("features", features)
+	d.Set("enabled_features", enabled)
+
+	return nil
+}
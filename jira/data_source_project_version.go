@@ -0,0 +1,160 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+)
+
+// VersionIssueCounts is returned by JIRA when counting the issues related to a version
+type VersionIssueCounts struct {
+	IssuesFixedCount    int `json:"issuesFixedCount"`
+	IssuesAffectedCount int `json:"issuesAffectedCount"`
+}
+
+// VersionUnresolvedIssueCount is returned by JIRA when counting the unresolved issues of a version
+type VersionUnresolvedIssueCount struct {
+	IssuesCount           int `json:"issuesCount"`
+	IssuesUnresolvedCount int `json:"issuesUnresolvedCount"`
+}
+
+// dataSourceProjectVersion is used to look up a version and its issue counts
+func dataSourceProjectVersion() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceProjectVersionRead,
+
+		Schema: map[string]*schema.Schema{
+			"version_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"version_id", "name"},
+			},
+			"project_key": {
+				Description:  "The project to look up the version by name in.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				RequiredWith: []string{"name"},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"version_id", "name"},
+				RequiredWith: []string{"project_key"},
+			},
+			// Computed values
+			"project_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"start_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"release_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"released": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"archived": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"overdue": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"issues_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"issues_fixed_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"issues_affected_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"issues_unresolved_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// findVersion returns the version of a project with the given name
+func findVersion(ctx context.Context, config *Config, projectKey string, name string) (*Version, error) {
+	var versions []Version
+	_, err := requestWithContext(ctx, config.jiraClient, "GET", fmt.Sprintf("%s/%s/versions", projectAPIEndpoint, projectKey), nil, &versions)
+	if err != nil {
+		return nil, errors.Wrap(err, "getting jira versions failed")
+	}
+
+	for _, version := range versions {
+		if strings.EqualFold(version.Name, name) {
+			return &version, nil
+		}
+	}
+
+	return nil, fmt.Errorf("version %q does not exist in project %s", name, projectKey)
+}
+
+func dataSourceProjectVersionRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*Config)
+
+	var version *Version
+	var err error
+
+	if versionID, ok := d.GetOk("version_id"); ok {
+		version, _, err = getVersion(ctx, config, versionID.(string))
+	} else {
+		version, err = findVersion(ctx, config, d.Get("project_key").(string), d.Get("name").(string))
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	counts := new(VersionIssueCounts)
+	_, err = requestWithContext(ctx, config.jiraClient, "GET", fmt.Sprintf("%s/%s/relatedIssueCounts", versionAPIEndpoint, version.ID), nil, counts)
+	if err != nil {
+		return diag.FromErr(errors.Wrap(err, "counting issues of jira version failed"))
+	}
+
+	unresolved := new(VersionUnresolvedIssueCount)
+	_, err = requestWithContext(ctx, config.jiraClient, "GET", fmt.Sprintf("%s/%s/unresolvedIssueCount", versionAPIEndpoint, version.ID), nil, unresolved)
+	if err != nil {
+		return diag.FromErr(errors.Wrap(err, "counting unresolved issues of jira version failed"))
+	}
+
+	d.SetId(version.ID)
+	d.Set("version_id", version.ID)
+	d.Set("name", version.Name)
+	d.Set("project_id", version.ProjectID)
+	d.Set("description", version.Description)
+	d.Set("start_date", version.StartDate)
+	d.Set("release_date", version.ReleaseDate)
+	d.Set("released", version.Released)
+	d.Set("archived", version.Archived)
+	d.Set("overdue", version.Overdue)
+	d.Set("issues_count", unresolved.IssuesCount)
+	d.Set("issues_fixed_count", counts.IssuesFixedCount)
+	d.Set("issues_affected_count", counts.IssuesAffectedCount)
+	d.Set("issues_unresolved_count", unresolved.IssuesUnresolvedCount)
+
+	return nil
+}
@@ -0,0 +1,173 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+)
+
+const projectSearchAPIEndpoint = "/rest/api/2/project/search"
+
+// projectSearchPageSize is the number of projects fetched per request
+const projectSearchPageSize = 50
+
+// ProjectSearchResponse is a page of projects returned by JIRA
+type ProjectSearchResponse struct {
+	StartAt    int               `json:"startAt"`
+	MaxResults int               `json:"maxResults"`
+	Total      int               `json:"total"`
+	IsLast     bool              `json:"isLast"`
+	Values     []ProjectResponse `json:"values"`
+}
+
+// dataSourceProjects is used to list the JIRA projects matching some filters
+func dataSourceProjects() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceProjectsRead,
+
+		Schema: map[string]*schema.Schema{
+			"category": {
+				Description: "Only return projects in the category with this name or ID.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"project_type_key": {
+				Description: "Only return projects of this type, e.g. software or business.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"query": {
+				Description: "Only return projects whose key or name contains this text.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			// Computed values
+			"keys": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"projects": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"project_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"key": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"project_type_key": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"style": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"category_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"category_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// listProjects returns all projects visible to the user. The query and type
+// are passed to instances which can search projects, the caller still has to
+// filter the result.
+func listProjects(ctx context.Context, config *Config, query string, projectTypeKey string) ([]ProjectResponse, error) {
+	projects := []ProjectResponse{}
+	startAt := 0
+
+	for {
+		parameters := url.Values{}
+		parameters.Set("startAt", fmt.Sprintf("%d", startAt))
+		parameters.Set("maxResults", fmt.Sprintf("%d", projectSearchPageSize))
+		if query != "" {
+			parameters.Set("query", query)
+		}
+		if projectTypeKey != "" {
+			parameters.Set("typeKey", projectTypeKey)
+		}
+
+		page := new(ProjectSearchResponse)
+		res, err := requestWithContext(ctx, config.jiraClient, "GET", fmt.Sprintf("%s?%s", projectSearchAPIEndpoint, parameters.Encode()), nil, page)
+		if err != nil {
+			if isNotFound(res) && startAt == 0 {
+				_, err := requestWithContext(ctx, config.jiraClient, "GET", projectAPIEndpoint, nil, &projects)
+				return projects, errors.Wrap(err, "listing jira projects failed")
+			}
+			return nil, errors.Wrap(err, "searching jira projects failed")
+		}
+
+		projects = append(projects, page.Values...)
+		startAt += len(page.Values)
+
+		if page.IsLast || len(page.Values) == 0 {
+			return projects, nil
+		}
+	}
+}
+
+func dataSourceProjectsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*Config)
+	category := d.Get("category").(string)
+	projectTypeKey := d.Get("project_type_key").(string)
+	query := d.Get("query").(string)
+
+	all, err := listProjects(ctx, config, query, projectTypeKey)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	keys := []string{}
+	projects := []interface{}{}
+	for _, project := range all {
+		if category != "" && project.ProjectCategory.ID != category && !strings.EqualFold(project.ProjectCategory.Name, category) {
+			continue
+		}
+		if projectTypeKey != "" && project.ProjectTypeKey != projectTypeKey {
+			continue
+		}
+		if query != "" && !strings.Contains(strings.ToLower(project.Key), strings.ToLower(query)) && !strings.Contains(strings.ToLower(project.Name), strings.ToLower(query)) {
+			continue
+		}
+
+		keys = append(keys, project.Key)
+		projects = append(projects, map[string]interface{}{
+			"project_id":       project.ID,
+			"key":              project.Key,
+			"name":             project.Name,
+			"project_type_key": project.ProjectTypeKey,
+			"style":            projectStyle(&project),
+			"category_id":      project.ProjectCategory.ID,
+			"category_name":    project.ProjectCategory.Name,
+		})
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", category, projectTypeKey, query))
+	d.Set("keys", keys)
+	d.Set("projects", projects)
+
+	return nil
+}
@@ -0,0 +1,283 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"html"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/pkg/errors"
+)
+
+// ReleaseNotes are the issues of a version, split into sections
+type ReleaseNotes struct {
+	Title    string                `json:"title"`
+	Sections []ReleaseNotesSection `json:"sections"`
+}
+
+// ReleaseNotesSection is a titled group of issues in the release notes
+type ReleaseNotesSection struct {
+	Title  string              `json:"title"`
+	Issues []ReleaseNotesIssue `json:"issues"`
+}
+
+// ReleaseNotesIssue is a single issue in the release notes
+type ReleaseNotesIssue struct {
+	Key     string `json:"key"`
+	Summary string `json:"summary"`
+	URL     string `json:"url"`
+}
+
+// dataSourceReleaseNotes is used to render the release notes of a version
+func dataSourceReleaseNotes() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceReleaseNotesRead,
+
+		Schema: map[string]*schema.Schema{
+			"project_key": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"version": {
+				Description: "Name or ID of the version.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"jql": {
+				Description: "Additional JQL the issues have to match, e.g. \"resolution = Done\".",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"group_by": {
+				Description: "Field the issues are grouped by, e.g. issuetype, priority or a custom field ID.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "issuetype",
+			},
+			"format": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "markdown",
+				ValidateFunc: validation.StringInSlice([]string{"markdown", "html", "json"}, false),
+			},
+			"title": {
+				Description: "Title of the release notes. Defaults to \"Release notes for <version>\".",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"section": {
+				Description: "Sections in the order they are rendered. Without sections every value of group_by gets its own section.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"title": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"values": {
+							Description: "Values of group_by whose issues are listed in this section.",
+							Type:        schema.TypeList,
+							Required:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"other_section_title": {
+				Description: "Title of the section for issues not matching any section. An empty title leaves these issues out.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "Other",
+			},
+			// Computed values
+			"content": {
+				Description: "The rendered release notes.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"issue_keys": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+// jqlString quotes a value for use in JQL
+func jqlString(value string) string {
+	return fmt.Sprintf(`"%s"`, strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(value))
+}
+
+func dataSourceReleaseNotesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*Config)
+	projectKey := d.Get("project_key").(string)
+	version := d.Get("version").(string)
+	groupBy := d.Get("group_by").(string)
+
+	jql := fmt.Sprintf("project = %s AND fixVersion = %s", jqlString(projectKey), jqlString(version))
+	if extra := d.Get("jql").(string); extra != "" {
+		jql = fmt.Sprintf("%s AND (%s)", jql, extra)
+	}
+	jql = fmt.Sprintf("%s ORDER BY key ASC", jql)
+
+	issueKeys := []string{}
+	groups := map[string][]ReleaseNotesIssue{}
+
+	handler := func(i SearchIssue) error {
+		var summary string
+		json.Unmarshal(i.Fields["summary"], &summary)
+
+		browseURL := config.jiraClient.GetBaseURL()
+		browseURL.Path = path.Join(browseURL.Path, "browse", i.Key)
+
+		issue := ReleaseNotesIssue{Key: i.Key, Summary: summary, URL: browseURL.String()}
+		for _, label := range fieldValueLabels(i.Fields[groupBy]) {
+			groups[label] = append(groups[label], issue)
+		}
+		issueKeys = append(issueKeys, i.Key)
+		return nil
+	}
+
+	if _, err := searchIssues(ctx, config, jql, []string{"summary", groupBy}, 0, handler); err != nil {
+		return diag.FromErr(err)
+	}
+
+	title := d.Get("title").(string)
+	if title == "" {
+		title = fmt.Sprintf("Release notes for %s", version)
+	}
+
+	notes := ReleaseNotes{
+		Title:    title,
+		Sections: releaseNotesSections(groups, d.Get("section").([]interface{}), d.Get("other_section_title").(string)),
+	}
+
+	content, err := renderReleaseNotes(notes, d.Get("format").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", projectKey, version))
+	d.Set("content", content)
+	d.Set("issue_keys", issueKeys)
+
+	return nil
+}
+
+// releaseNotesSections distributes the grouped issues over the configured
+// sections. Without configured sections, every group becomes a section.
+func releaseNotesSections(groups map[string][]ReleaseNotesIssue, configured []interface{}, otherTitle string) []ReleaseNotesSection {
+	labels := []string{}
+	for label := range groups {
+		labels = append(labels, label)
+	}
+	sort.Strings(labels)
+
+	sections := []ReleaseNotesSection{}
+
+	if len(configured) == 0 {
+		for _, label := range labels {
+			sections = append(sections, ReleaseNotesSection{Title: label, Issues: groups[label]})
+		}
+		return sections
+	}
+
+	used := map[string]bool{}
+	for _, raw := range configured {
+		s := raw.(map[string]interface{})
+		section := ReleaseNotesSection{Title: s["title"].(string), Issues: []ReleaseNotesIssue{}}
+
+		for _, value := range stringList(s["values"]) {
+			for _, label := range labels {
+				if strings.EqualFold(label, value) && !used[label] {
+					section.Issues = appendReleaseNotesIssues(section.Issues, groups[label])
+					used[label] = true
+				}
+			}
+		}
+
+		if len(section.Issues) > 0 {
+			sections = append(sections, section)
+		}
+	}
+
+	if otherTitle != "" {
+		other := ReleaseNotesSection{Title: otherTitle, Issues: []ReleaseNotesIssue{}}
+		for _, label := range labels {
+			if !used[label] {
+				other.Issues = appendReleaseNotesIssues(other.Issues, groups[label])
+			}
+		}
+		if len(other.Issues) > 0 {
+			sections = append(sections, other)
+		}
+	}
+
+	return sections
+}
+
+// appendReleaseNotesIssues appends the issues which are not yet part of a
+// section, as issues with several values of a multi-value field are grouped
+// under each value
+func appendReleaseNotesIssues(issues []ReleaseNotesIssue, additional []ReleaseNotesIssue) []ReleaseNotesIssue {
+	for _, issue := range additional {
+		found := false
+		for _, existing := range issues {
+			found = found || existing.Key == issue.Key
+		}
+		if !found {
+			issues = append(issues, issue)
+		}
+	}
+	return issues
+}
+
+// markdownEscaper escapes the characters with a meaning within a line of markdown,
+// so summaries and titles show up as written
+var markdownEscaper = strings.NewReplacer(
+	`\`, `\\`, "`", "\\`", `*`, `\*`, `_`, `\_`, `[`, `\[`, `]`, `\]`,
+	`<`, `\<`, `>`, `\>`, `#`, `\#`, `!`, `\!`, `|`, `\|`, `~`, `\~`, `&`, `\&`,
+)
+
+// renderReleaseNotes renders the release notes as markdown, html or json
+func renderReleaseNotes(notes ReleaseNotes, format string) (string, error) {
+	var b strings.Builder
+
+	switch format {
+	case "markdown":
+		fmt.Fprintf(&b, "# %s\n", markdownEscaper.Replace(notes.Title))
+		for _, section := range notes.Sections {
+			fmt.Fprintf(&b, "\n## %s\n\n", markdownEscaper.Replace(section.Title))
+			for _, issue := range section.Issues {
+				fmt.Fprintf(&b, "- [%s](%s) %s\n", markdownEscaper.Replace(issue.Key), issue.URL, markdownEscaper.Replace(issue.Summary))
+			}
+		}
+	case "html":
+		fmt.Fprintf(&b, "<h1>%s</h1>\n", html.EscapeString(notes.Title))
+		for _, section := range notes.Sections {
+			fmt.Fprintf(&b, "<h2>%s</h2>\n<ul>\n", html.EscapeString(section.Title))
+			for _, issue := range section.Issues {
+				fmt.Fprintf(&b, "<li><a href=\"%s\">%s</a> %s</li>\n", html.EscapeString(issue.URL), html.EscapeString(issue.Key), html.EscapeString(issue.Summary))
+			}
+			b.WriteString("</ul>\n")
+		}
+	case "json":
+		encoded, err := json.Marshal(notes)
+		if err != nil {
+			return "", errors.Wrap(err, "encoding release notes failed")
+		}
+		b.Write(encoded)
+	default:
+		return "", fmt.Errorf("unsupported release notes format %q", format)
+	}
+
+	return b.String(), nil
+}
@@ -0,0 +1,60 @@
+package jira
+
+import (
+	"testing"
+)
+
+func TestReleaseNotes(t *testing.T) {
+	groups := map[string][]ReleaseNotesIssue{
+		"Bug":   {{Key: "PROJ-2", Summary: "Crash on start", URL: "https://jira.example.org/browse/PROJ-2"}},
+		"Story": {{Key: "PROJ-1", Summary: "Login <SSO>", URL: "https://jira.example.org/browse/PROJ-1"}},
+		"Task":  {{Key: "PROJ-3", Summary: "Update docs", URL: "https://jira.example.org/browse/PROJ-3"}},
+	}
+
+	sections := []interface{}{
+		map[string]interface{}{"title": "Features", "values": []interface{}{"story"}},
+		map[string]interface{}{"title": "Fixes", "values": []interface{}{"Bug"}},
+	}
+
+	cases := []struct {
+		format   string
+		sections []interface{}
+		other    string
+		expected string
+	}{
+		{"markdown", nil, "Other", "# 1.0\n\n## Bug\n\n- [PROJ-2](https://jira.example.org/browse/PROJ-2) Crash on start\n\n## Story\n\n- [PROJ-1](https://jira.example.org/browse/PROJ-1) Login \\<SSO\\>\n\n## Task\n\n- [PROJ-3](https://jira.example.org/browse/PROJ-3) Update docs\n"},
+		{"markdown", sections, "Other", "# 1.0\n\n## Features\n\n- [PROJ-1](https://jira.example.org/browse/PROJ-1) Login \\<SSO\\>\n\n## Fixes\n\n- [PROJ-2](https://jira.example.org/browse/PROJ-2) Crash on start\n\n## Other\n\n- [PROJ-3](https://jira.example.org/browse/PROJ-3) Update docs\n"},
+		{"html", sections, "", "<h1>1.0</h1>\n<h2>Features</h2>\n<ul>\n<li><a href=\"https://jira.example.org/browse/PROJ-1\">PROJ-1</a> Login &lt;SSO&gt;</li>\n</ul>\n<h2>Fixes</h2>\n<ul>\n<li><a href=\"https://jira.example.org/browse/PROJ-2\">PROJ-2</a> Crash on start</li>\n</ul>\n"},
+		{"json", sections[1:], "", `{"title":"1.0","sections":[{"title":"Fixes","issues":[{"key":"PROJ-2","summary":"Crash on start","url":"https://jira.example.org/browse/PROJ-2"}]}]}`},
+	}
+
+	for _, c := range cases {
+		notes := ReleaseNotes{Title: "1.0", Sections: releaseNotesSections(groups, c.sections, c.other)}
+		content, err := renderReleaseNotes(notes, c.format)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if content != c.expected {
+			t.Errorf("renderReleaseNotes(%s) = %q, expected %q", c.format, content, c.expected)
+		}
+	}
+
+	// Markdown characters in titles and summaries are escaped
+	escaped := map[string][]ReleaseNotesIssue{
+		"Bug": {{Key: "PROJ-4", Summary: "Fix *bold* [links](x) in `code_blocks`", URL: "https://jira.example.org/browse/PROJ-4"}},
+	}
+	escapedSections := []interface{}{
+		map[string]interface{}{"title": "Fixes #1", "values": []interface{}{"Bug"}},
+	}
+
+	notes := ReleaseNotes{Title: "1.0 <beta>", Sections: releaseNotesSections(escaped, escapedSections, "")}
+	content, err := renderReleaseNotes(notes, "markdown")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := "# 1.0 \\<beta\\>\n\n## Fixes \\#1\n\n- [PROJ-4](https://jira.example.org/browse/PROJ-4) Fix \\*bold\\* \\[links\\](x) in \\`code\\_blocks\\`\n"
+	if content != expected {
+		t.Errorf("renderReleaseNotes(markdown) = %q, expected %q", content, expected)
+	}
+}
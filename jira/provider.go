@@ -38,23 +38,41 @@ func Provider() *schema.Provider {
 		},
 		ResourcesMap: map[string]*schema.Resource{
 			"jira_comment":            resourceComment(),
+			"jira_entity_property":    resourceEntityProperty(),
 			"jira_filter":             resourceFilter(),
 			"jira_group":              resourceGroup(),
 			"jira_group_membership":   resourceGroupMembership(),
 			"jira_issue":              resourceIssue(),
+			"jira_issues":             resourceIssues(),
 			"jira_issue_link":         resourceIssueLink(),
+			"jira_issue_remote_link":  resourceIssueRemoteLink(),
+			"jira_issue_transition":   resourceIssueTransition(),
 			"jira_issue_type":         resourceIssueType(),
 			"jira_issue_link_type":    resourceIssueLinkType(),
 			"jira_project":            resourceProject(),
 			"jira_project_category":   resourceProjectCategory(),
+			"jira_project_component":  resourceProjectComponent(),
+			"jira_project_features":   resourceProjectFeatures(),
+			"jira_project_version":    resourceProjectVersion(),
 			"jira_project_membership": resourceProjectMembership(),
 			"jira_webhook":            resourceWebhook(),
 			"jira_role":               resourceRole(),
 			"jira_user":               resourceUser(),
 		},
 		DataSourcesMap: map[string]*schema.Resource{
-			"jira_field": resourceField(),
-			"jira_jql":   resourceJQL(),
+			"jira_field":              resourceField(),
+			"jira_issue":              dataSourceIssue(),
+			"jira_issue_changelog":    dataSourceIssueChangelog(),
+			"jira_issue_create_meta":  dataSourceIssueCreateMeta(),
+			"jira_issue_transitions":  dataSourceIssueTransitions(),
+			"jira_jql":                resourceJQL(),
+			"jira_jql_count":          dataSourceJQLCount(),
+			"jira_project":            dataSourceProject(),
+			"jira_project_components": dataSourceProjectComponents(),
+			"jira_project_features":   dataSourceProjectFeatures(),
+			"jira_project_version":    dataSourceProjectVersion(),
+			"jira_projects":           dataSourceProjects(),
+			"jira_release_notes":      dataSourceReleaseNotes(),
 		},
 		ConfigureFunc: providerConfigure,
 	}
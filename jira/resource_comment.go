@@ -1,20 +1,70 @@
 package jira
 
 import (
-	"io/ioutil"
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
 
-	"github.com/andygrunwald/go-jira"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/pkg/errors"
 )
 
+func commentAPIEndpoint(issueKey string) string {
+	return fmt.Sprintf("/rest/api/2/issue/%s/comment", issueKey)
+}
+
+// CommentVisibility restricts who can see a comment
+type CommentVisibility struct {
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
+// CommentProperty is a key-value pair stored with a comment
+type CommentProperty struct {
+	Key   string      `json:"key"`
+	Value interface{} `json:"value"`
+}
+
+// CommentRequest is sent to JIRA to create or update a comment
+type CommentRequest struct {
+	Body       string             `json:"body"`
+	Visibility *CommentVisibility `json:"visibility,omitempty"`
+	Properties []CommentProperty  `json:"properties,omitempty"`
+}
+
+// CommentUpdateRequest is sent to JIRA to update a comment. Unlike on
+// creation, a missing visibility is sent as null to make the comment public again.
+type CommentUpdateRequest struct {
+	CommentRequest
+	Visibility *CommentVisibility `json:"visibility"`
+}
+
+// Comment is returned by JIRA for a single comment
+type Comment struct {
+	ID     string `json:"id"`
+	Body   string `json:"body"`
+	Author struct {
+		AccountID string `json:"accountId"`
+	} `json:"author"`
+	Created    string             `json:"created"`
+	Updated    string             `json:"updated"`
+	Visibility *CommentVisibility `json:"visibility"`
+	Properties []struct {
+		Key   string          `json:"key"`
+		Value json.RawMessage `json:"value"`
+	} `json:"properties"`
+}
+
 // resourceComment is used to define a JIRA comment
 func resourceComment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceCommentCreate,
-		Read:   resourceCommentRead,
-		Update: resourceCommentUpdate,
-		Delete: resourceCommentDelete,
+		CreateContext: resourceCommentCreate,
+		ReadContext:   resourceCommentRead,
+		UpdateContext: resourceCommentUpdate,
+		DeleteContext: resourceCommentDelete,
 
 		Schema: map[string]*schema.Schema{
 			"body": {
@@ -26,90 +76,197 @@ func resourceComment() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+			"visibility": commentVisibilitySchema(),
+			"properties": {
+				Description: "Properties of the comment. Values which are valid JSON are sent decoded.",
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			// Computed values
+			"author_account_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"updated": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// commentVisibilitySchema describes who can see a comment
+func commentVisibilitySchema() *schema.Schema {
+	return &schema.Schema{
+		Description: "Restricts the comment to the members of a project role or group.",
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"type": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice([]string{"role", "group"}, false),
+				},
+				"value": {
+					Description: "Name of the role or group.",
+					Type:        schema.TypeString,
+					Required:    true,
+				},
+			},
 		},
 	}
 }
 
+// expandCommentVisibility converts a visibility block to its JIRA representation
+func expandCommentVisibility(visibility []interface{}) *CommentVisibility {
+	if len(visibility) == 0 || visibility[0] == nil {
+		return nil
+	}
+
+	v := visibility[0].(map[string]interface{})
+	return &CommentVisibility{
+		Type:  v["type"].(string),
+		Value: v["value"].(string),
+	}
+}
+
+// commentRequest builds the request to create or update a comment from the configuration
+func commentRequest(d *schema.ResourceData) CommentRequest {
+	request := CommentRequest{
+		Body:       d.Get("body").(string),
+		Visibility: expandCommentVisibility(d.Get("visibility").([]interface{})),
+	}
+
+	for key, value := range d.Get("properties").(map[string]interface{}) {
+		request.Properties = append(request.Properties, CommentProperty{Key: key, Value: decodeJSONString(value.(string))})
+	}
+
+	return request
+}
+
+// decodeJSONString returns the decoded value if s is valid JSON and s itself otherwise
+func decodeJSONString(s string) interface{} {
+	var decoded interface{}
+	if json.Valid([]byte(s)) && json.Unmarshal([]byte(s), &decoded) == nil {
+		return decoded
+	}
+	return s
+}
+
 // resourceCommentCreate creates a new jira comment using the jira api
-func resourceCommentCreate(d *schema.ResourceData, m interface{}) error {
+func resourceCommentCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	config := m.(*Config)
-	body := d.Get("body").(string)
 	issueKey := d.Get("issue_key").(string)
 
-	c := jira.Comment{Body: body}
-
-	comment, res, err := config.jiraClient.Issue.AddComment(issueKey, &c)
-
+	comment := new(Comment)
+	_, err := requestWithContext(ctx, config.jiraClient, "POST", commentAPIEndpoint(issueKey), commentRequest(d), comment)
 	if err != nil {
-		body, _ := ioutil.ReadAll(res.Body)
-		return errors.Wrapf(err, "creating jira issue failed: %s", body)
+		return diag.FromErr(errors.Wrap(err, "creating jira comment failed"))
 	}
 
 	d.SetId(comment.ID)
 
-	return resourceCommentRead(d, m)
+	return resourceCommentRead(ctx, d, m)
 }
 
 // resourceCommentRead reads comment details using jira api
-func resourceCommentRead(d *schema.ResourceData, m interface{}) error {
+func resourceCommentRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	config := m.(*Config)
+	endpoint := fmt.Sprintf("%s/%s?expand=properties", commentAPIEndpoint(d.Get("issue_key").(string)), d.Id())
 
-	issue, res, err := config.jiraClient.Issue.Get(d.Get("issue_key").(string), nil)
+	comment := new(Comment)
+	res, err := requestWithContext(ctx, config.jiraClient, "GET", endpoint, nil, comment)
 	if err != nil {
-		body, _ := ioutil.ReadAll(res.Body)
-		return errors.Wrapf(err, "getting jira issue failed: %s", body)
-	}
-
-	var comment *jira.Comment
-	for _, c := range issue.Fields.Comments.Comments {
-		if c.ID == d.Id() {
-			comment = c
-			break
+		if isNotFound(res) {
+			d.SetId("")
+			return nil
 		}
+		return diag.FromErr(errors.Wrap(err, "getting jira comment failed"))
 	}
 
-	if comment == nil {
-		d.SetId("")
-		return nil
+	d.Set("body", comment.Body)
+	d.Set("author_account_id", comment.Author.AccountID)
+	d.Set("created", comment.Created)
+	d.Set("updated", comment.Updated)
+
+	visibility := []interface{}{}
+	if comment.Visibility != nil {
+		visibility = append(visibility, map[string]interface{}{
+			"type":  comment.Visibility.Type,
+			"value": comment.Visibility.Value,
+		})
 	}
+	d.Set("visibility", visibility)
 
-	d.Set("body", comment.Body)
+	// Apps attach their own properties to comments, only the configured ones are tracked
+	configured := d.Get("properties").(map[string]interface{})
+	properties := map[string]string{}
+	for _, property := range comment.Properties {
+		existing, ok := configured[property.Key]
+		if !ok {
+			continue
+		}
+
+		var value interface{}
+		json.Unmarshal(property.Value, &value)
+
+		if reflect.DeepEqual(decodeJSONString(existing.(string)), value) {
+			properties[property.Key] = existing.(string)
+		} else {
+			properties[property.Key] = string(property.Value)
+		}
+	}
+	d.Set("properties", properties)
 
 	return nil
 }
 
 // resourceCommentUpdate updates jira comment using jira api
-func resourceCommentUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceCommentUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	config := m.(*Config)
-	body := d.Get("body").(string)
-	issueKey := d.Get("issue_key").(string)
+	endpoint := fmt.Sprintf("%s/%s", commentAPIEndpoint(d.Get("issue_key").(string)), d.Id())
 
-	i := jira.Comment{
-		ID:   d.Id(),
-		Body: body,
-	}
-
-	comment, res, err := config.jiraClient.Issue.UpdateComment(issueKey, &i)
+	request := commentRequest(d)
+	update := CommentUpdateRequest{CommentRequest: request, Visibility: request.Visibility}
 
+	_, err := requestWithContext(ctx, config.jiraClient, "PUT", endpoint, update, nil)
 	if err != nil {
-		body, _ := ioutil.ReadAll(res.Body)
-		return errors.Wrapf(err, "updating jira comment failed: %s", body)
+		return diag.FromErr(errors.Wrap(err, "updating jira comment failed"))
 	}
 
-	d.SetId(comment.ID)
+	// Updating a comment keeps properties which are not sent, so removed ones
+	// are deleted one by one
+	oldProperties, newProperties := d.GetChange("properties")
+	for key := range oldProperties.(map[string]interface{}) {
+		if _, ok := newProperties.(map[string]interface{})[key]; ok {
+			continue
+		}
 
-	return resourceCommentRead(d, m)
+		propertyEndpoint, _ := entityPropertyEndpoint("comment", d.Id(), key)
+		res, err := requestWithContext(ctx, config.jiraClient, "DELETE", propertyEndpoint, nil, nil)
+		if err != nil && !isNotFound(res) {
+			return diag.FromErr(errors.Wrapf(err, "deleting property %s of jira comment failed", key))
+		}
+	}
+
+	return resourceCommentRead(ctx, d, m)
 }
 
 // resourceCommentDelete deletes jira comment using the jira api
-func resourceCommentDelete(d *schema.ResourceData, m interface{}) error {
+func resourceCommentDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	config := m.(*Config)
 	issueKey := d.Get("issue_key").(string)
-	id := d.Id()
 
-	err := config.jiraClient.Issue.DeleteComment(issueKey, id)
-	if err != nil {
-		return errors.Wrapf(err, "deleting jira comment failed")
+	res, err := requestWithContext(ctx, config.jiraClient, "DELETE", fmt.Sprintf("%s/%s", commentAPIEndpoint(issueKey), d.Id()), nil, nil)
+	if err != nil && !isNotFound(res) {
+		return diag.FromErr(errors.Wrap(err, "deleting jira comment failed"))
 	}
 	return nil
 }
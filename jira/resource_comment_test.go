@@ -0,0 +1,24 @@
+package jira
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestCommentUpdateRequest(t *testing.T) {
+	cases := []struct {
+		visibility *CommentVisibility
+		expected   string
+	}{
+		{nil, `{"body":"Hello","visibility":null}`},
+		{&CommentVisibility{Type: "role", Value: "Administrators"}, `{"body":"Hello","visibility":{"type":"role","value":"Administrators"}}`},
+	}
+
+	for _, c := range cases {
+		request := CommentRequest{Body: "Hello", Visibility: c.visibility}
+		encoded, err := json.Marshal(CommentUpdateRequest{CommentRequest: request, Visibility: request.Visibility})
+		if err != nil || string(encoded) != c.expected {
+			t.Errorf("encoded update request = %s, %v, want %s", encoded, err, c.expected)
+		}
+	}
+}
@@ -0,0 +1,228 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/pkg/errors"
+)
+
+// EntityProperty represents a property stored on a JIRA entity
+type EntityProperty struct {
+	Key   string          `json:"key,omitempty"`
+	Value json.RawMessage `json:"value,omitempty"`
+}
+
+var entityPropertyTypes = []string{
+	"issue",
+	"project",
+	"user",
+	"comment",
+	"issuetype",
+	"dashboard_item",
+}
+
+// entityPropertyEndpoint returns the endpoint of the property key on the
+// given entity. Dashboard items are addressed as "<dashboardId>/<itemId>",
+// users by their account id.
+func entityPropertyEndpoint(entityType string, entityID string, key string) (string, error) {
+	key = url.PathEscape(key)
+
+	switch entityType {
+	case "issue":
+		return fmt.Sprintf("/rest/api/2/issue/%s/properties/%s", entityID, key), nil
+	case "project":
+		return fmt.Sprintf("%s/%s/properties/%s", projectAPIEndpoint, entityID, key), nil
+	case "user":
+		return fmt.Sprintf("/rest/api/2/user/properties/%s?accountId=%s", key, url.QueryEscape(entityID)), nil
+	case "comment":
+		return fmt.Sprintf("/rest/api/2/comment/%s/properties/%s", entityID, key), nil
+	case "issuetype":
+		return fmt.Sprintf("%s/%s/properties/%s", issueTypeAPIEndpoint, entityID, key), nil
+	case "dashboard_item":
+		components := strings.SplitN(entityID, "/", 2)
+		if len(components) != 2 {
+			return "", fmt.Errorf("entity_id of a dashboard_item must be formatted as <dashboard_id>/<item_id>, got %q", entityID)
+		}
+		return fmt.Sprintf("/rest/api/2/dashboard/%s/items/%s/properties/%s", components[0], components[1], key), nil
+	}
+
+	return "", fmt.Errorf("unsupported entity_type %q", entityType)
+}
+
+// resourceEntityProperty is used to define a property on a JIRA entity
+func resourceEntityProperty() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceEntityPropertyCreate,
+		ReadContext:   resourceEntityPropertyRead,
+		UpdateContext: resourceEntityPropertyUpdate,
+		DeleteContext: resourceEntityPropertyDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceEntityPropertyImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"entity_type": {
+				Description:  "The type of the entity: issue, project, user, comment, issuetype or dashboard_item.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(entityPropertyTypes, false),
+			},
+			"entity_id": {
+				Description: "The ID or key of the entity. Users are referenced by account id, dashboard items as <dashboard_id>/<item_id>.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"key": {
+				Description: "The key of the property.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"value": {
+				Description:      "The value of the property as JSON.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: structure.SuppressJsonDiff,
+				StateFunc: func(v interface{}) string {
+					normalized, _ := structure.NormalizeJsonString(v)
+					return normalized
+				},
+			},
+		},
+	}
+}
+
+func entityPropertyID(entityType string, entityID string, key string) string {
+	return fmt.Sprintf("%s/%s/%s", entityType, entityID, key)
+}
+
+// resourceEntityPropertyPut stores the configured value on the entity
+func resourceEntityPropertyPut(ctx context.Context, d *schema.ResourceData, m interface{}) error {
+	config := m.(*Config)
+
+	endpoint, err := entityPropertyEndpoint(d.Get("entity_type").(string), d.Get("entity_id").(string), d.Get("key").(string))
+	if err != nil {
+		return err
+	}
+
+	value := json.RawMessage(d.Get("value").(string))
+
+	_, err = requestWithContext(ctx, config.jiraClient, "PUT", endpoint, value, nil)
+	if err != nil {
+		return errors.Wrap(err, "setting entity property failed")
+	}
+
+	return nil
+}
+
+// resourceEntityPropertyCreate creates a new entity property using the jira api
+func resourceEntityPropertyCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if err := resourceEntityPropertyPut(ctx, d, m); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(entityPropertyID(d.Get("entity_type").(string), d.Get("entity_id").(string), d.Get("key").(string)))
+
+	return resourceEntityPropertyRead(ctx, d, m)
+}
+
+// resourceEntityPropertyRead reads entity property details using jira api
+func resourceEntityPropertyRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*Config)
+
+	endpoint, err := entityPropertyEndpoint(d.Get("entity_type").(string), d.Get("entity_id").(string), d.Get("key").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	property := new(EntityProperty)
+	res, err := requestWithContext(ctx, config.jiraClient, "GET", endpoint, nil, property)
+	if err != nil {
+		if isNotFound(res) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(errors.Wrap(err, "getting entity property failed"))
+	}
+
+	value, err := structure.NormalizeJsonString(string(property.Value))
+	if err != nil {
+		return diag.FromErr(errors.Wrap(err, "normalizing entity property failed"))
+	}
+
+	d.Set("value", value)
+
+	return nil
+}
+
+// resourceEntityPropertyUpdate updates entity property using jira api
+func resourceEntityPropertyUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if err := resourceEntityPropertyPut(ctx, d, m); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceEntityPropertyRead(ctx, d, m)
+}
+
+// resourceEntityPropertyDelete deletes entity property using the jira api
+func resourceEntityPropertyDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*Config)
+
+	endpoint, err := entityPropertyEndpoint(d.Get("entity_type").(string), d.Get("entity_id").(string), d.Get("key").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	res, err := requestWithContext(ctx, config.jiraClient, "DELETE", endpoint, nil, nil)
+	if err != nil && !isNotFound(res) {
+		return diag.FromErr(errors.Wrap(err, "deleting entity property failed"))
+	}
+
+	return nil
+}
+
+// parseEntityPropertyID splits an ID built by entityPropertyID. The key may
+// contain "/", so only the entity ID components are split off.
+func parseEntityPropertyID(id string) (string, string, string, error) {
+	components := strings.SplitN(id, "/", 3)
+	if len(components) < 3 {
+		return "", "", "", fmt.Errorf("import id must be formatted as <entity_type>/<entity_id>/<key>, got %q", id)
+	}
+
+	// Dashboard items are addressed as <dashboard_id>/<item_id>
+	if components[0] == "dashboard_item" {
+		components = strings.SplitN(id, "/", 4)
+		if len(components) < 4 {
+			return "", "", "", fmt.Errorf("import id of a dashboard_item must be formatted as dashboard_item/<dashboard_id>/<item_id>/<key>, got %q", id)
+		}
+		return components[0], fmt.Sprintf("%s/%s", components[1], components[2]), components[3], nil
+	}
+
+	return components[0], components[1], components[2], nil
+}
+
+// resourceEntityPropertyImport imports an entity property by <entity_type>/<entity_id>/<key>
+func resourceEntityPropertyImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	entityType, entityID, key, err := parseEntityPropertyID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("entity_type", entityType)
+	d.Set("entity_id", entityID)
+	d.Set("key", key)
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,42 @@
+package jira
+
+import (
+	"testing"
+)
+
+func TestEntityPropertyID(t *testing.T) {
+	cases := []struct {
+		entityType string
+		entityID   string
+		key        string
+		endpoint   string
+	}{
+		{"issue", "TEST-1", "com.example.flag", "/rest/api/2/issue/TEST-1/properties/com.example.flag"},
+		{"project", "TEST", "team/owner", "/rest/api/2/project/TEST/properties/team%2Fowner"},
+		{"user", "5b10a2844c20165700ede21g", "settings", "/rest/api/2/user/properties/settings?accountId=5b10a2844c20165700ede21g"},
+		{"dashboard_item", "10000/10100", "config/v1", "/rest/api/2/dashboard/10000/items/10100/properties/config%2Fv1"},
+	}
+
+	for _, c := range cases {
+		endpoint, err := entityPropertyEndpoint(c.entityType, c.entityID, c.key)
+		if err != nil || endpoint != c.endpoint {
+			t.Errorf("entityPropertyEndpoint(%q, %q, %q) = %q, %v, want %q", c.entityType, c.entityID, c.key, endpoint, err, c.endpoint)
+		}
+
+		id := entityPropertyID(c.entityType, c.entityID, c.key)
+		entityType, entityID, key, err := parseEntityPropertyID(id)
+		if err != nil || entityType != c.entityType || entityID != c.entityID || key != c.key {
+			t.Errorf("parseEntityPropertyID(%q) = %q, %q, %q, %v", id, entityType, entityID, key, err)
+		}
+	}
+
+	if _, err := entityPropertyEndpoint("dashboard_item", "10000", "config"); err == nil {
+		t.Error("entityPropertyEndpoint of a dashboard item without item ID succeeded")
+	}
+
+	for _, id := range []string{"issue/TEST-1", "dashboard_item/10000/config"} {
+		if _, _, _, err := parseEntityPropertyID(id); err == nil {
+			t.Errorf("parseEntityPropertyID(%q) succeeded", id)
+		}
+	}
+}
@@ -12,6 +12,15 @@ var (
 	fieldsCache []jira.Field
 )
 
+// scopedFieldAPIEndpoint lists the fields including their scope
+const scopedFieldAPIEndpoint = "/rest/api/3/field"
+
+// ScopedField is a field which may belong to a team-managed project
+type ScopedField struct {
+	jira.Field
+	Scope *Scope `json:"scope,omitempty"`
+}
+
 // JIRA field
 func resourceField() *schema.Resource {
 	return &schema.Resource{
@@ -22,6 +31,11 @@ func resourceField() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			"project_id": {
+				Description: "ID of the team-managed project to look up the field in. JIRA has no API to create fields in team-managed projects, so they can only be looked up.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
 			"clause_names": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -55,17 +69,32 @@ func resourceFieldRead(d *schema.ResourceData, m interface{}) error {
 	config := m.(*Config)
 	name := d.Get("name").(string)
 
-	if fieldsCache == nil || len(fieldsCache) == 0 {
-		fields, _, err := config.jiraClient.Field.GetList()
+	var field *jira.Field
+
+	if projectID, ok := d.GetOk("project_id"); ok {
+		var fields []ScopedField
+		err := request(config.jiraClient, "GET", scopedFieldAPIEndpoint, nil, &fields)
 		if err != nil {
 			return errors.Wrapf(err, "fetching jira fields failed")
 		}
-		fieldsCache = fields
-	}
 
-	field := findFieldByName(fieldsCache, name)
-	if field == nil {
-		return errors.New(fmt.Sprintf("field with name '%s' not found", name))
+		field = findScopedFieldByName(fields, name, projectID.(string))
+		if field == nil {
+			return errors.New(fmt.Sprintf("field with name '%s' not found in project %s", name, projectID))
+		}
+	} else {
+		if fieldsCache == nil || len(fieldsCache) == 0 {
+			fields, _, err := config.jiraClient.Field.GetList()
+			if err != nil {
+				return errors.Wrapf(err, "fetching jira fields failed")
+			}
+			fieldsCache = fields
+		}
+
+		field = findFieldByName(fieldsCache, name)
+		if field == nil {
+			return errors.New(fmt.Sprintf("field with name '%s' not found", name))
+		}
 	}
 
 	d.SetId(field.ID)
@@ -87,3 +116,24 @@ func findFieldByName(fields []jira.Field, name string) *jira.Field {
 	}
 	return nil
 }
+
+// findScopedFieldByName returns the field of a team-managed project with the
+// given name. Fields shared by all projects are used if the project has none.
+func findScopedFieldByName(fields []ScopedField, name string, projectID string) *jira.Field {
+	var shared *jira.Field
+	for _, field := range fields {
+		if field.Name != name {
+			continue
+		}
+		switch scopeProjectID(field.Scope) {
+		case projectID:
+			return &field.Field
+		case "":
+			if shared == nil {
+				sharedField := field.Field
+				shared = &sharedField
+			}
+		}
+	}
+	return shared
+}
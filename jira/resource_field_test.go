@@ -0,0 +1,37 @@
+package jira
+
+import (
+	"testing"
+
+	"github.com/andygrunwald/go-jira"
+)
+
+func TestFindScopedFieldByName(t *testing.T) {
+	fields := []ScopedField{
+		{Field: jira.Field{ID: "customfield_10001", Name: "Story Points"}},
+		{Field: jira.Field{ID: "customfield_10002", Name: "Story Points"}, Scope: projectScope("10100")},
+		{Field: jira.Field{ID: "customfield_10003", Name: "Team"}, Scope: projectScope("10200")},
+	}
+
+	cases := []struct {
+		name      string
+		projectID string
+		id        string
+	}{
+		{"Story Points", "10100", "customfield_10002"},
+		{"Story Points", "10200", "customfield_10001"},
+		{"Team", "10200", "customfield_10003"},
+		{"Team", "10100", ""},
+	}
+
+	for _, c := range cases {
+		field := findScopedFieldByName(fields, c.name, c.projectID)
+		id := ""
+		if field != nil {
+			id = field.ID
+		}
+		if id != c.id {
+			t.Errorf("findScopedFieldByName(%q, %q) = %q, want %q", c.name, c.projectID, id, c.id)
+		}
+	}
+}
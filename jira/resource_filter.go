@@ -59,6 +59,7 @@ func resourceFilter() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: jqlCustomizeDiff("jql"),
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
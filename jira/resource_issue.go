@@ -1,11 +1,18 @@
 package jira
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/andygrunwald/go-jira"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/pkg/errors"
 	"github.com/trivago/tgo/tcontainer"
@@ -21,6 +28,13 @@ func resourceIssue() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: resourceIssueImport,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(20 * time.Minute),
+		},
+		CustomizeDiff: customdiff.All(
+			resourceIssueCustomizeDiff,
+			resourceIssueValidateFields,
+		),
 
 		Schema: map[string]*schema.Schema{
 			"assignee": {
@@ -69,9 +83,12 @@ func resourceIssue() *schema.Resource {
 				Required: true,
 			},
 			"parent": {
-				Type:     schema.TypeString,
-				Optional: true,
-				ForceNew: true,
+				Description: "Key (or ID) of the parent issue. Changing it moves the issue to the new parent, except for sub-tasks on JIRA Data Center, which are recreated.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return new != "" && new == d.Get("parent_id").(string)
+				},
 			},
 			"state": {
 				Type:     schema.TypeString,
@@ -91,11 +108,29 @@ func resourceIssue() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"move_target_status": {
+				Description: "ID of the status the issue ends up in when it is moved to another project or issue type. Jira infers the status if omitted.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"move_mandatory_fields": {
+				Description: "Values for fields which are required by the target project or issue type when the issue is moved.",
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type:     schema.TypeString,
+					Required: true,
+				},
+			},
 			// Computed values
 			"issue_key": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"parent_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -139,27 +174,13 @@ func resourceIssueCreate(d *schema.ResourceData, m interface{}) error {
 	}
 
 	if parent != "" {
-		i.Fields.Parent = &jira.Parent{
-			ID: parent.(string),
-		}
+		i.Fields.Unknowns = tcontainer.NewMarshalMap()
+		i.Fields.Unknowns.Set("parent", issueParent(parent.(string)))
 	}
 
 	if fields != nil {
-		if i.Fields.Unknowns == nil {
-			i.Fields.Unknowns = tcontainer.NewMarshalMap()
-		}
-		for field, value := range fields.(map[string]interface{}) {
-			var decodedValue interface{}
-			valueBytes := []byte(value.(string))
-
-			if json.Valid(valueBytes) {
-				if err := json.Unmarshal([]byte(value.(string)), &decodedValue); err != nil {
-					return err
-				}
-				i.Fields.Unknowns.Set(field, decodedValue)
-			} else {
-				i.Fields.Unknowns.Set(field, value.(string))
-			}
+		if err := setIssueUnknowns(i.Fields, fields.(map[string]interface{})); err != nil {
+			return err
 		}
 	}
 
@@ -184,10 +205,8 @@ func resourceIssueCreate(d *schema.ResourceData, m interface{}) error {
 	if state, ok := d.GetOk("state"); ok {
 		if issue.Fields.Status.ID != state.(string) {
 			if transition, ok := d.GetOk("state_transition"); ok {
-				res, err := config.jiraClient.Issue.DoTransition(issue.ID, transition.(string))
-				if err != nil {
-					body, _ := ioutil.ReadAll(res.Body)
-					return errors.Wrapf(err, "transitioning jira issue failed: %s", body)
+				if err := transitionIssue(context.Background(), config.jiraClient, issue.ID, transition.(string), nil); err != nil {
+					return err
 				}
 			}
 		}
@@ -221,46 +240,19 @@ func resourceIssueRead(d *schema.ResourceData, m interface{}) error {
 		d.Set("reporter", issue.Fields.Reporter.Name)
 	}
 
+	d.Set("parent", "")
+	d.Set("parent_id", "")
 	if issue.Fields.Parent != nil {
 		d.Set("parent", issue.Fields.Parent.Key)
+		d.Set("parent_id", issue.Fields.Parent.ID)
 	}
 
 	// Custom or non-standard fields
 	var resourceFieldsRaw, resourceHasFields = d.GetOk("fields")
 	if resourceHasFields {
-		incomingFields := make(map[string]string)
-		resourceFields := resourceFieldsRaw.(map[string]interface{})
-		for field := range issue.Fields.Unknowns {
-			if existingField, fieldExists := resourceFields[field]; fieldExists {
-				if value, valueExists := issue.Fields.Unknowns.Value(field); valueExists {
-					existingFieldBytes := []byte(existingField.(string))
-
-					if json.Valid(existingFieldBytes) {
-						var decodedExistingValue interface{}
-						if err := json.Unmarshal([]byte(existingField.(string)), &decodedExistingValue); err != nil {
-							return err
-						}
-
-						marshalledValue, _ := json.Marshal(extractSameKeys(decodedExistingValue, value))
-						incomingFields[field] = string(marshalledValue)
-					} else {
-						switch value.(type) {
-						case string:
-							incomingFields[field] = value.(string)
-						case bool:
-							incomingFields[field] = fmt.Sprintf("%t", value.(bool))
-						case int:
-							incomingFields[field] = fmt.Sprintf("%d", value.(int))
-						case float32:
-							incomingFields[field] = fmt.Sprintf("%f", value.(float32))
-						case float64:
-							incomingFields[field] = fmt.Sprintf("%f", value.(float64))
-						case uint:
-							incomingFields[field] = fmt.Sprintf("%d", value.(uint))
-						}
-					}
-				}
-			}
+		incomingFields, err := getIssueUnknowns(issue, resourceFieldsRaw.(map[string]interface{}))
+		if err != nil {
+			return err
 		}
 		d.Set("fields", incomingFields)
 	}
@@ -287,18 +279,24 @@ func resourceIssueUpdate(d *schema.ResourceData, m interface{}) error {
 	config := m.(*Config)
 	issueKey := d.Get("issue_key").(string)
 
+	if d.HasChanges("project_key", "issue_type") {
+		if err := moveIssue(config, d); err != nil {
+			return err
+		}
+		// The key changes when the issue moves to another project
+		issueKey = d.Id()
+	} else if d.HasChange("parent") {
+		if err := reparentIssue(config, d); err != nil {
+			return err
+		}
+	}
+
 	i := jira.Issue{
 		Key:    issueKey,
 		ID:     d.Id(),
 		Fields: &jira.IssueFields{},
 	}
 
-	if issueType := d.Get("issue_type").(string); d.HasChange("issue_type") {
-		i.Fields.Type = jira.IssueType{
-			Name: issueType,
-		}
-	}
-
 	if description := d.Get("description").(string); d.HasChange("description") {
 		i.Fields.Description = description
 	}
@@ -307,12 +305,6 @@ func resourceIssueUpdate(d *schema.ResourceData, m interface{}) error {
 		i.Fields.Summary = summary
 	}
 
-	if projectKey := d.Get("project_key").(string); d.HasChange("project_key") {
-		i.Fields.Project = jira.Project{
-			Key: projectKey,
-		}
-	}
-
 	if assignee := d.Get("assignee"); d.HasChange("assignee") && assignee != "" {
 		i.Fields.Assignee = &jira.User{
 			Name: assignee.(string),
@@ -332,21 +324,8 @@ func resourceIssueUpdate(d *schema.ResourceData, m interface{}) error {
 	}
 
 	if fields := d.Get("fields"); d.HasChange("fields") && fields != nil && len(fields.(map[string]interface{})) > 0 {
-		if i.Fields.Unknowns == nil {
-			i.Fields.Unknowns = tcontainer.NewMarshalMap()
-		}
-		for field, value := range fields.(map[string]interface{}) {
-			var decodedValue interface{}
-			valueBytes := []byte(value.(string))
-
-			if json.Valid(valueBytes) {
-				if err := json.Unmarshal([]byte(value.(string)), &decodedValue); err != nil {
-					return err
-				}
-				i.Fields.Unknowns.Set(field, decodedValue)
-			} else {
-				i.Fields.Unknowns.Set(field, value.(string))
-			}
+		if err := setIssueUnknowns(i.Fields, fields.(map[string]interface{})); err != nil {
+			return err
 		}
 	}
 
@@ -365,10 +344,8 @@ func resourceIssueUpdate(d *schema.ResourceData, m interface{}) error {
 	if state, ok := d.GetOk("state"); ok {
 		if issue.Fields.Status.ID != state.(string) {
 			if transition, ok := d.GetOk("state_transition"); ok {
-				res, err := config.jiraClient.Issue.DoTransition(issue.ID, transition.(string))
-				if err != nil {
-					body, _ := ioutil.ReadAll(res.Body)
-					return errors.Wrapf(err, "transitioning jira issue failed: %s", body)
+				if err := transitionIssue(context.Background(), config.jiraClient, issue.ID, transition.(string), nil); err != nil {
+					return err
 				}
 			}
 		}
@@ -386,10 +363,8 @@ func resourceIssueDelete(d *schema.ResourceData, m interface{}) error {
 	id := d.Id()
 
 	if transition, ok := d.GetOk("delete_transition"); ok {
-		res, err := config.jiraClient.Issue.DoTransition(id, transition.(string))
-		if err != nil {
-			body, _ := ioutil.ReadAll(res.Body)
-			return errors.Wrapf(err, "deleting jira issue failed: %s", body)
+		if err := transitionIssue(context.Background(), config.jiraClient, id, transition.(string), nil); err != nil {
+			return errors.Wrap(err, "deleting jira issue failed")
 		}
 
 	} else {
@@ -404,6 +379,273 @@ func resourceIssueDelete(d *schema.ResourceData, m interface{}) error {
 	return nil
 }
 
+// transitionIssue performs the transition on the issue, setting the given
+// fields on the way
+func transitionIssue(ctx context.Context, client *jira.Client, issueID string, transitionID string, fields map[string]interface{}) error {
+	payload := map[string]interface{}{
+		"transition": jira.TransitionPayload{ID: transitionID},
+	}
+	if len(fields) > 0 {
+		payload["fields"] = fields
+	}
+
+	_, err := client.Issue.DoTransitionWithPayloadWithContext(ctx, issueID, payload)
+	if err != nil {
+		return errors.Wrap(err, "transitioning jira issue failed")
+	}
+
+	return nil
+}
+
+// resourceIssueCustomizeDiff marks the issue key as unknown if the issue is
+// going to be moved to another project
+func resourceIssueCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	if d.HasChange("parent") {
+		if err := resourceIssueDiffParent(ctx, d, m.(*Config)); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("project_key") {
+		return d.SetNewComputed("issue_key")
+	}
+	return nil
+}
+
+// resourceIssueDiffParent recreates sub-tasks whose parent changes on JIRA
+// Data Center, which can only move sub-tasks between parents on JIRA Cloud
+func resourceIssueDiffParent(ctx context.Context, d *schema.ResourceDiff, config *Config) error {
+	issue, res, err := config.jiraClient.Issue.GetWithContext(ctx, d.Id(), nil)
+	if isNotFound(res) {
+		return nil
+	}
+	if err != nil {
+		return errors.Wrap(err, "getting jira issue failed")
+	}
+
+	if issue.Fields == nil || !issue.Fields.Type.Subtask {
+		return nil
+	}
+
+	cloud, err := isCloud(config.jiraClient)
+	if err != nil {
+		return err
+	}
+	if cloud {
+		return nil
+	}
+
+	return d.ForceNew("parent")
+}
+
+const issueEditMetaAPIEndpoint = "/rest/api/2/issue/%s/editmeta"
+
+// EditMetaResponse is returned by JIRA when asking for the edit screen of an issue
+type EditMetaResponse struct {
+	Fields map[string]MetaField `json:"fields"`
+}
+
+// issueAttributeFields maps the attributes of jira_issue to the JIRA fields they set
+var issueAttributeFields = map[string]string{
+	"assignee":    "assignee",
+	"reporter":    "reporter",
+	"description": "description",
+	"labels":      "labels",
+	"summary":     "summary",
+	"parent":      "parent",
+	"project_key": "project",
+	"issue_type":  "issuetype",
+}
+
+// getEditMetaFields returns the fields on the edit screen of an issue, keyed by field ID
+func getEditMetaFields(ctx context.Context, config *Config, issueID string) (map[string]MetaField, error) {
+	meta := new(EditMetaResponse)
+	_, err := requestWithContext(ctx, config.jiraClient, "GET", fmt.Sprintf(issueEditMetaAPIEndpoint, issueID), nil, meta)
+	if err != nil {
+		return nil, errors.Wrap(err, "getting edit meta failed")
+	}
+	return meta.Fields, nil
+}
+
+// resourceIssueValidateFields checks the configured fields against the create
+// screen of new issues and the edit screen of existing ones, so missing or
+// invalid fields are reported during plan
+func resourceIssueValidateFields(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if !d.NewValueKnown("project_key") || !d.NewValueKnown("issue_type") {
+		return nil
+	}
+	if raw := d.GetRawConfig(); !raw.IsNull() && !raw.GetAttr("fields").IsWhollyKnown() {
+		return nil
+	}
+
+	moving := d.HasChange("project_key") || d.HasChange("issue_type")
+	if d.Id() != "" && !moving && !d.HasChange("fields") {
+		return nil
+	}
+
+	config := m.(*Config)
+	var metaFields map[string]MetaField
+	var err error
+
+	if d.Id() == "" || moving {
+		var res *jira.Response
+		metaFields, res, err = getCreateMetaFields(ctx, config, d.Get("project_key").(string), d.Get("issue_type").(string))
+		// The project may be created in the same apply
+		if isNotFound(res) {
+			log.Printf("[DEBUG] Skipping validation of issue fields, as project %s does not exist yet", d.Get("project_key"))
+			return nil
+		}
+	} else {
+		metaFields, err = getEditMetaFields(ctx, config, d.Id())
+	}
+	if err != nil {
+		return err
+	}
+
+	set := map[string]bool{}
+	for attribute, field := range issueAttributeFields {
+		if _, ok := d.GetOk(attribute); ok {
+			set[field] = true
+		}
+	}
+
+	return validateIssueFields(metaFields, d.Get("fields").(map[string]interface{}), set, d.Id() == "")
+}
+
+// validateIssueFields reports fields which are not on the screen, values
+// which are not allowed and, if checkRequired is set, required fields which
+// are neither set nor have a default value
+func validateIssueFields(metaFields map[string]MetaField, fields map[string]interface{}, set map[string]bool, checkRequired bool) error {
+	problems := []string{}
+
+	ids := []string{}
+	for id := range fields {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	for _, id := range ids {
+		field, ok := metaFields[id]
+		if !ok {
+			problem := "field does not exist or is not on the screen"
+			for metaID, metaField := range metaFields {
+				if strings.EqualFold(metaField.Name, id) {
+					problem = fmt.Sprintf("fields are configured by ID, use %s instead", metaID)
+				}
+			}
+			problems = append(problems, fmt.Sprintf("fields.%s: %s", id, problem))
+			continue
+		}
+
+		set[id] = true
+
+		if invalid := disallowedFieldValues(field, fields[id].(string)); len(invalid) > 0 {
+			problems = append(problems, fmt.Sprintf("fields.%s: %s is not allowed for %s, allowed values: %s",
+				id, strings.Join(invalid, ", "), field.Name, strings.Join(allowedValueLabels(field), ", ")))
+		}
+	}
+
+	if checkRequired {
+		ids = []string{}
+		for id := range metaFields {
+			ids = append(ids, id)
+		}
+		sort.Strings(ids)
+
+		for _, id := range ids {
+			field := metaFields[id]
+			if field.Required && !field.HasDefaultValue && !set[id] {
+				problems = append(problems, fmt.Sprintf("%s: %s is required", issueFieldPath(id), field.Name))
+			}
+		}
+	}
+
+	if len(problems) == 0 {
+		return nil
+	}
+
+	return fmt.Errorf("invalid issue fields\n%s", strings.Join(problems, "\n"))
+}
+
+// issueFieldPath returns the attribute of jira_issue a field is configured with
+func issueFieldPath(id string) string {
+	for attribute, field := range issueAttributeFields {
+		if field == id {
+			return attribute
+		}
+	}
+	return fmt.Sprintf("fields.%s", id)
+}
+
+// disallowedFieldValues returns the configured values which are not among
+// the allowed values of a field. Fields without a list of allowed values
+// accept everything.
+func disallowedFieldValues(field MetaField, configured string) []string {
+	if len(field.AllowedValues) == 0 {
+		return nil
+	}
+
+	allowed := map[string]bool{}
+	for _, raw := range field.AllowedValues {
+		var value interface{}
+		if json.Unmarshal(raw, &value) == nil {
+			for _, identifier := range fieldValueIdentifiers(value) {
+				allowed[strings.ToLower(identifier)] = true
+			}
+		}
+	}
+
+	var value interface{} = configured
+	if json.Valid([]byte(configured)) {
+		json.Unmarshal([]byte(configured), &value)
+	}
+
+	values := []interface{}{value}
+	if list, ok := value.([]interface{}); ok {
+		values = list
+	}
+
+	invalid := []string{}
+	for _, v := range values {
+		if v == nil {
+			continue
+		}
+
+		valid := false
+		for _, identifier := range fieldValueIdentifiers(v) {
+			valid = valid || allowed[strings.ToLower(identifier)]
+		}
+		if !valid {
+			invalid = append(invalid, fieldValueLabel(v))
+		}
+	}
+
+	return invalid
+}
+
+// fieldValueIdentifiers returns the ways a field value can be referred to
+func fieldValueIdentifiers(value interface{}) []string {
+	switch v := value.(type) {
+	case string:
+		return []string{v}
+	case float64:
+		return []string{strconv.FormatFloat(v, 'f', -1, 64)}
+	case map[string]interface{}:
+		identifiers := []string{}
+		for _, key := range []string{"id", "key", "name", "value"} {
+			if identifier, ok := v[key]; ok && identifier != nil {
+				identifiers = append(identifiers, fmt.Sprintf("%v", identifier))
+			}
+		}
+		return identifiers
+	}
+	return nil
+}
+
 // resourceIssueImport imports jira issue using the jira api
 func resourceIssueImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	err := resourceIssueRead(d, m)
@@ -413,6 +655,67 @@ func resourceIssueImport(d *schema.ResourceData, m interface{}) ([]*schema.Resou
 	return []*schema.ResourceData{d}, nil
 }
 
+// setIssueUnknowns sets the configured custom or non-standard fields. Values
+// which are valid JSON are sent decoded, all others as plain strings.
+func setIssueUnknowns(issueFields *jira.IssueFields, fields map[string]interface{}) error {
+	if issueFields.Unknowns == nil {
+		issueFields.Unknowns = tcontainer.NewMarshalMap()
+	}
+	for field, value := range fields {
+		var decodedValue interface{}
+		valueBytes := []byte(value.(string))
+
+		if json.Valid(valueBytes) {
+			if err := json.Unmarshal([]byte(value.(string)), &decodedValue); err != nil {
+				return err
+			}
+			issueFields.Unknowns.Set(field, decodedValue)
+		} else {
+			issueFields.Unknowns.Set(field, value.(string))
+		}
+	}
+	return nil
+}
+
+// getIssueUnknowns returns the values of the configured custom or
+// non-standard fields in the shape they were configured in
+func getIssueUnknowns(issue *jira.Issue, fields map[string]interface{}) (map[string]string, error) {
+	incomingFields := make(map[string]string)
+	for field := range issue.Fields.Unknowns {
+		if existingField, fieldExists := fields[field]; fieldExists {
+			if value, valueExists := issue.Fields.Unknowns.Value(field); valueExists {
+				existingFieldBytes := []byte(existingField.(string))
+
+				if json.Valid(existingFieldBytes) {
+					var decodedExistingValue interface{}
+					if err := json.Unmarshal([]byte(existingField.(string)), &decodedExistingValue); err != nil {
+						return nil, err
+					}
+
+					marshalledValue, _ := json.Marshal(extractSameKeys(decodedExistingValue, value))
+					incomingFields[field] = string(marshalledValue)
+				} else {
+					switch value.(type) {
+					case string:
+						incomingFields[field] = value.(string)
+					case bool:
+						incomingFields[field] = fmt.Sprintf("%t", value.(bool))
+					case int:
+						incomingFields[field] = fmt.Sprintf("%d", value.(int))
+					case float32:
+						incomingFields[field] = fmt.Sprintf("%f", value.(float32))
+					case float64:
+						incomingFields[field] = fmt.Sprintf("%f", value.(float64))
+					case uint:
+						incomingFields[field] = fmt.Sprintf("%d", value.(uint))
+					}
+				}
+			}
+		}
+	}
+	return incomingFields, nil
+}
+
 // extractSameKeys pulls the values from extendedInput which match keys is baseInput
 func extractSameKeys(baseInput interface{}, extendedInput interface{}) interface{} {
 	switch baseInput.(type) {
@@ -439,3 +742,223 @@ func extractSameKeys(baseInput interface{}, extendedInput interface{}) interface
 
 	return extendedInput
 }
+
+// BulkMoveSpec describes where a set of issues is moved to
+type BulkMoveSpec struct {
+	InferFieldDefaults      bool                    `json:"inferFieldDefaults"`
+	InferStatusDefaults     bool                    `json:"inferStatusDefaults"`
+	InferSubtaskTypeDefault bool                    `json:"inferSubtaskTypeDefault"`
+	IssueIdsOrKeys          []string                `json:"issueIdsOrKeys"`
+	TargetMandatoryFields   []BulkMoveFields        `json:"targetMandatoryFields,omitempty"`
+	TargetStatus            []BulkMoveStatusMapping `json:"targetStatus,omitempty"`
+}
+
+// BulkMoveFields maps field IDs to the values used in the target
+type BulkMoveFields struct {
+	Fields map[string]BulkMoveFieldValue `json:"fields"`
+}
+
+// BulkMoveFieldValue is the value of a field in the target
+type BulkMoveFieldValue struct {
+	Retain bool        `json:"retain"`
+	Type   string      `json:"type"`
+	Value  interface{} `json:"value"`
+}
+
+// BulkMoveStatusMapping maps target status IDs to the source status IDs
+type BulkMoveStatusMapping struct {
+	Statuses map[string][]string `json:"statuses"`
+}
+
+// BulkMoveRequest is sent to JIRA Cloud to move issues between projects and issue types
+type BulkMoveRequest struct {
+	SendBulkNotification   bool                    `json:"sendBulkNotification"`
+	TargetToSourcesMapping map[string]BulkMoveSpec `json:"targetToSourcesMapping"`
+}
+
+// BulkTaskResponse is returned by JIRA after submitting a bulk operation
+type BulkTaskResponse struct {
+	TaskID string `json:"taskId"`
+}
+
+// BulkTaskProgress describes the progress of a bulk operation
+type BulkTaskProgress struct {
+	TaskID                 string              `json:"taskId"`
+	Status                 string              `json:"status"`
+	ProgressPercent        int                 `json:"progressPercent"`
+	FailedAccessibleIssues map[string][]string `json:"failedAccessibleIssues"`
+}
+
+const bulkMoveAPIEndpoint = "/rest/api/3/bulk/issues/move"
+const bulkQueueAPIEndpoint = "/rest/api/3/bulk/queue"
+
+// findIssueTypeID resolves the ID of an issue type by name within a project
+func findIssueTypeID(client *jira.Client, projectKey string, issueTypeName string) (string, error) {
+	project, res, err := client.Project.Get(projectKey)
+	if err != nil {
+		body, _ := ioutil.ReadAll(res.Body)
+		return "", errors.Wrapf(err, "getting jira project failed: %s", body)
+	}
+
+	for _, issueType := range project.IssueTypes {
+		if strings.EqualFold(issueType.Name, issueTypeName) {
+			return issueType.ID, nil
+		}
+	}
+
+	return "", fmt.Errorf("issue type %q does not exist in project %s", issueTypeName, projectKey)
+}
+
+// moveIssue moves the issue to the configured project and issue type. Jira
+// only supports this on Cloud, using the bulk move API.
+func moveIssue(config *Config, d *schema.ResourceData) error {
+	cloud, err := isCloud(config.jiraClient)
+	if err != nil {
+		return err
+	}
+
+	oldProjectKey, newProjectKey := d.GetChange("project_key")
+	oldIssueType, newIssueType := d.GetChange("issue_type")
+
+	if !cloud {
+		oldParent, newParent := d.GetChange("parent")
+		return fmt.Errorf(
+			"moving issue %s from %s/%s (parent %q) to %s/%s (parent %q) is only supported on JIRA Cloud, recreate the issue instead",
+			d.Get("issue_key"), oldProjectKey, oldIssueType, oldParent, newProjectKey, newIssueType, newParent)
+	}
+
+	issueTypeID, err := findIssueTypeID(config.jiraClient, newProjectKey.(string), newIssueType.(string))
+	if err != nil {
+		return err
+	}
+
+	target := fmt.Sprintf("%s,%s", newProjectKey, issueTypeID)
+	if parent, ok := d.GetOk("parent"); ok {
+		target = fmt.Sprintf("%s,%s", target, parent)
+	}
+
+	spec := BulkMoveSpec{
+		InferFieldDefaults:      true,
+		InferStatusDefaults:     true,
+		InferSubtaskTypeDefault: true,
+		IssueIdsOrKeys:          []string{d.Id()},
+	}
+
+	if targetStatus, ok := d.GetOk("move_target_status"); ok {
+		issue, res, err := config.jiraClient.Issue.Get(d.Id(), nil)
+		if err != nil {
+			body, _ := ioutil.ReadAll(res.Body)
+			return errors.Wrapf(err, "getting jira issue failed: %s", body)
+		}
+
+		spec.InferStatusDefaults = false
+		spec.TargetStatus = []BulkMoveStatusMapping{{
+			Statuses: map[string][]string{
+				targetStatus.(string): {issue.Fields.Status.ID},
+			},
+		}}
+	}
+
+	if mandatoryFields, ok := d.GetOk("move_mandatory_fields"); ok {
+		fields := map[string]BulkMoveFieldValue{}
+		for field, value := range mandatoryFields.(map[string]interface{}) {
+			var decodedValue interface{} = value.(string)
+			if json.Valid([]byte(value.(string))) {
+				if err := json.Unmarshal([]byte(value.(string)), &decodedValue); err != nil {
+					return err
+				}
+			}
+			fields[field] = BulkMoveFieldValue{Retain: false, Type: "raw", Value: decodedValue}
+		}
+		spec.TargetMandatoryFields = []BulkMoveFields{{Fields: fields}}
+	}
+
+	move := BulkMoveRequest{
+		SendBulkNotification:   false,
+		TargetToSourcesMapping: map[string]BulkMoveSpec{target: spec},
+	}
+
+	task := new(BulkTaskResponse)
+	err = request(config.jiraClient, "POST", bulkMoveAPIEndpoint, move, task)
+	if err != nil {
+		return errors.Wrap(err, "moving jira issue failed")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), d.Timeout(schema.TimeoutUpdate))
+	defer cancel()
+
+	return waitForTask(ctx, bulkTask(config.jiraClient, task.TaskID))
+}
+
+// reparentIssue moves the issue to the configured parent. Sub-tasks are moved
+// with the bulk move API, which only exists on Cloud, all other issues by
+// updating their parent field.
+func reparentIssue(config *Config, d *schema.ResourceData) error {
+	issue, res, err := config.jiraClient.Issue.Get(d.Id(), nil)
+	if err != nil {
+		body, _ := ioutil.ReadAll(res.Body)
+		return errors.Wrapf(err, "getting jira issue failed: %s", body)
+	}
+
+	parent := d.Get("parent").(string)
+
+	if issue.Fields.Type.Subtask {
+		if parent == "" {
+			return fmt.Errorf("sub-task %s requires a parent", issue.Key)
+		}
+
+		return moveIssue(config, d)
+	}
+
+	fields := map[string]interface{}{
+		"parent": nil,
+	}
+	if parent != "" {
+		fields["parent"] = issueParent(parent)
+	}
+
+	res, err = config.jiraClient.Issue.UpdateIssue(d.Id(), map[string]interface{}{"fields": fields})
+	if err != nil {
+		body, _ := ioutil.ReadAll(res.Body)
+		return errors.Wrapf(err, "updating parent of jira issue failed: %s", body)
+	}
+
+	return nil
+}
+
+// issueParent references the parent by ID if parent is numeric, by key otherwise
+func issueParent(parent string) map[string]string {
+	if _, err := strconv.Atoi(parent); err == nil {
+		return map[string]string{"id": parent}
+	}
+	return map[string]string{"key": parent}
+}
+
+// bulkTask follows a bulk operation, which fails if any of its issues failed
+func bulkTask(client *jira.Client, taskID string) AsyncTask {
+	urlStr := fmt.Sprintf("%s/%s", bulkQueueAPIEndpoint, taskID)
+
+	return AsyncTask{
+		Name: fmt.Sprintf("bulk task %s", taskID),
+		Poll: func(ctx context.Context) (*TaskState, error) {
+			progress := new(BulkTaskProgress)
+			if _, err := requestWithContext(ctx, client, "GET", urlStr, nil, progress); err != nil {
+				return nil, err
+			}
+
+			state := &TaskState{Status: progress.Status, Progress: progress.ProgressPercent}
+			switch progress.Status {
+			case "COMPLETE":
+				state.Done = true
+				for issue, messages := range progress.FailedAccessibleIssues {
+					state.Failed = true
+					state.Errors = append(state.Errors, fmt.Sprintf("issue %s: %s", issue, strings.Join(messages, ", ")))
+				}
+			case "FAILED", "CANCEL_REQUESTED", "CANCELLED", "DEAD":
+				state.Done = true
+				state.Failed = true
+			}
+			return state, nil
+		},
+	}
+}
@@ -1,21 +1,37 @@
 package jira
 
 import (
+	"context"
 	"fmt"
+	"log"
 	"strings"
 
 	"github.com/andygrunwald/go-jira"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/pkg/errors"
 )
 
+// IssueLinkRequest is sent to JIRA to link two issues
+type IssueLinkRequest struct {
+	Type         jira.IssueLinkType `json:"type"`
+	InwardIssue  jira.Issue         `json:"inwardIssue"`
+	OutwardIssue jira.Issue         `json:"outwardIssue"`
+	Comment      *CommentRequest    `json:"comment,omitempty"`
+}
+
+// IssueLinkTypesResponse is returned by JIRA when listing issue link types
+type IssueLinkTypesResponse struct {
+	IssueLinkTypes []jira.IssueLinkType `json:"issueLinkTypes"`
+}
+
 func resourceIssueLink() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceIssueLinkCreate,
-		Read:   resourceIssueLinkRead,
-		Delete: resourceIssueLinkDelete,
+		CreateContext: resourceIssueLinkCreate,
+		ReadContext:   resourceIssueLinkRead,
+		DeleteContext: resourceIssueLinkDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: resourceIssueLinkImport,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -30,34 +46,100 @@ func resourceIssueLink() *schema.Resource {
 				ForceNew: true,
 			},
 			"link_type": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Description:  "ID of the issue link type.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"link_type", "link_type_name"},
+			},
+			"link_type_name": {
+				Description:      "Name of the issue link type, e.g. Blocks.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         true,
+				ExactlyOneOf:     []string{"link_type", "link_type_name"},
+				DiffSuppressFunc: caseInsensitiveSuppressFunc,
+			},
+			"comment": {
+				Description: "Comment added to the outward issue when linking. Changing it recreates the link.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				ForceNew:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"body": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"visibility": func() *schema.Schema {
+							visibility := commentVisibilitySchema()
+							visibility.ForceNew = true
+							return visibility
+						}(),
+					},
+				},
 			},
 		},
 	}
 }
 
+// findIssueLinkType returns the issue link type with the given name
+func findIssueLinkType(ctx context.Context, config *Config, name string) (*jira.IssueLinkType, error) {
+	linkTypes := new(IssueLinkTypesResponse)
+	_, err := requestWithContext(ctx, config.jiraClient, "GET", issueLinkTypeAPIEndpoint, nil, linkTypes)
+	if err != nil {
+		return nil, errors.Wrap(err, "getting issue link types failed")
+	}
+
+	names := []string{}
+	for _, linkType := range linkTypes.IssueLinkTypes {
+		if strings.EqualFold(linkType.Name, name) {
+			return &linkType, nil
+		}
+		names = append(names, linkType.Name)
+	}
+
+	return nil, fmt.Errorf("issue link type %q does not exist, available link types: %s", name, strings.Join(names, ", "))
+}
+
 // resourceIssueLinkCreate creates a new jira issue using the jira api
-func resourceIssueLinkCreate(d *schema.ResourceData, m interface{}) error {
+func resourceIssueLinkCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	config := m.(*Config)
 
-	issueLink := new(jira.IssueLink)
+	issueLink := IssueLinkRequest{
+		Type:         jira.IssueLinkType{ID: d.Get("link_type").(string)},
+		InwardIssue:  jira.Issue{Key: d.Get("inward_key").(string)},
+		OutwardIssue: jira.Issue{Key: d.Get("outward_key").(string)},
+	}
 
-	issueLink.InwardIssue = &jira.Issue{Key: d.Get("inward_key").(string)}
-	issueLink.OutwardIssue = &jira.Issue{Key: d.Get("outward_key").(string)}
-	issueLink.Type = jira.IssueLinkType{ID: d.Get("link_type").(string)}
+	if name, ok := d.GetOk("link_type_name"); ok {
+		linkType, err := findIssueLinkType(ctx, config, name.(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		issueLink.Type.ID = linkType.ID
+	}
 
-	resp, err := config.jiraClient.Issue.AddLink(issueLink)
+	if comment := d.Get("comment").([]interface{}); len(comment) > 0 && comment[0] != nil {
+		c := comment[0].(map[string]interface{})
+		issueLink.Comment = &CommentRequest{
+			Body:       c["body"].(string),
+			Visibility: expandCommentVisibility(c["visibility"].([]interface{})),
+		}
+	}
 
+	res, err := requestWithContext(ctx, config.jiraClient, "POST", issueLinkAPIEndpoint, issueLink, nil)
 	if err != nil {
-		return errors.Wrap(err, "Creating Issue Link failed")
+		return diag.FromErr(errors.Wrap(err, "Creating Issue Link failed"))
 	}
 
-	location, err := resp.Location()
-
+	location, err := res.Location()
 	if err != nil {
-		return errors.Wrap(err, "Creating Issue Link failed")
+		return diag.FromErr(errors.Wrap(err, "Creating Issue Link failed"))
 	}
 
 	components := strings.Split(location.Path, "/")
@@ -65,38 +147,82 @@ func resourceIssueLinkCreate(d *schema.ResourceData, m interface{}) error {
 
 	d.SetId(ID)
 
-	return resourceIssueLinkRead(d, m)
+	return resourceIssueLinkRead(ctx, d, m)
 }
 
 // resourceIssueLinkRead reads issue details using jira api
-func resourceIssueLinkRead(d *schema.ResourceData, m interface{}) error {
+func resourceIssueLinkRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	config := m.(*Config)
 
 	urlStr := fmt.Sprintf("%s/%s", issueLinkAPIEndpoint, d.Id())
 	issueLink := new(jira.IssueLink)
 
-	err := request(config.jiraClient, "GET", urlStr, nil, issueLink)
+	res, err := requestWithContext(ctx, config.jiraClient, "GET", urlStr, nil, issueLink)
 	if err != nil {
-		return errors.Wrap(err, "Request failed")
+		if isNotFound(res) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(errors.Wrap(err, "Request failed"))
+	}
+
+	if issueLink.InwardIssue == nil || issueLink.OutwardIssue == nil {
+		log.Printf("[WARN] Issue link %s is missing one of its issues, removing it from state", d.Id())
+		d.SetId("")
+		return nil
 	}
 
 	d.Set("inward_key", issueLink.InwardIssue.Key)
 	d.Set("outward_key", issueLink.OutwardIssue.Key)
 	d.Set("link_type", issueLink.Type.ID)
+	d.Set("link_type_name", issueLink.Type.Name)
 
 	return nil
 }
 
 // resourceIssueLinkDelete deletes jira issue using the jira api
-func resourceIssueLinkDelete(d *schema.ResourceData, m interface{}) error {
+func resourceIssueLinkDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	config := m.(*Config)
 
 	urlStr := fmt.Sprintf("%s/%s", issueLinkAPIEndpoint, d.Id())
 
-	err := request(config.jiraClient, "DELETE", urlStr, nil, nil)
-	if err != nil {
-		return errors.Wrap(err, "Request failed")
+	res, err := requestWithContext(ctx, config.jiraClient, "DELETE", urlStr, nil, nil)
+	if err != nil && !isNotFound(res) {
+		return diag.FromErr(errors.Wrap(err, "Request failed"))
 	}
 
 	return nil
 }
+
+// resourceIssueLinkImport imports a link by its ID or by
+// INWARD_KEY/OUTWARD_KEY/LINK_TYPE, where the link type is a name or an ID
+func resourceIssueLinkImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	components := strings.SplitN(d.Id(), "/", 3)
+	if len(components) == 1 {
+		return []*schema.ResourceData{d}, nil
+	}
+	if len(components) != 3 {
+		return nil, fmt.Errorf("unexpected format of ID (%s), expected LINK_ID or INWARD_KEY/OUTWARD_KEY/LINK_TYPE", d.Id())
+	}
+
+	config := m.(*Config)
+	inwardKey, outwardKey, linkType := components[0], components[1], components[2]
+
+	issue, _, err := config.jiraClient.Issue.GetWithContext(ctx, inwardKey, &jira.GetQueryOptions{Fields: "issuelinks"})
+	if err != nil {
+		return nil, errors.Wrap(err, "getting jira issue failed")
+	}
+
+	// The links of the inward issue point to the outward issue
+	for _, link := range issue.Fields.IssueLinks {
+		if link.OutwardIssue == nil || !strings.EqualFold(link.OutwardIssue.Key, outwardKey) {
+			continue
+		}
+		if link.Type.ID == linkType || strings.EqualFold(link.Type.Name, linkType) {
+			d.SetId(link.ID)
+			return []*schema.ResourceData{d}, nil
+		}
+	}
+
+	return nil, fmt.Errorf("no %s link from %s to %s found", linkType, inwardKey, outwardKey)
+}
@@ -0,0 +1,317 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+)
+
+// RemoteLinkIcon represents the icon shown next to a remote link
+type RemoteLinkIcon struct {
+	URL16x16 string `json:"url16x16,omitempty"`
+	Title    string `json:"title,omitempty"`
+	Link     string `json:"link,omitempty"`
+}
+
+// RemoteLinkStatus represents the status of the object a remote link points to
+type RemoteLinkStatus struct {
+	Resolved bool            `json:"resolved"`
+	Icon     *RemoteLinkIcon `json:"icon,omitempty"`
+}
+
+// RemoteLinkObject represents the object a remote link points to
+type RemoteLinkObject struct {
+	URL     string            `json:"url"`
+	Title   string            `json:"title"`
+	Summary string            `json:"summary,omitempty"`
+	Icon    *RemoteLinkIcon   `json:"icon,omitempty"`
+	Status  *RemoteLinkStatus `json:"status,omitempty"`
+}
+
+// RemoteLinkApplication represents the application a remote link belongs to
+type RemoteLinkApplication struct {
+	Type string `json:"type,omitempty"`
+	Name string `json:"name,omitempty"`
+}
+
+// RemoteLink represents a link from a JIRA issue to an external system
+type RemoteLink struct {
+	ID           int                    `json:"id,omitempty"`
+	Self         string                 `json:"self,omitempty"`
+	GlobalID     string                 `json:"globalId,omitempty"`
+	Application  *RemoteLinkApplication `json:"application,omitempty"`
+	Relationship string                 `json:"relationship,omitempty"`
+	Object       RemoteLinkObject       `json:"object"`
+}
+
+func issueRemoteLinkAPIEndpoint(issueKey string) string {
+	return fmt.Sprintf("/rest/api/2/issue/%s/remotelink", issueKey)
+}
+
+// resourceIssueRemoteLink is used to define a link from a JIRA issue to an external system
+func resourceIssueRemoteLink() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIssueRemoteLinkCreate,
+		ReadContext:   resourceIssueRemoteLinkRead,
+		UpdateContext: resourceIssueRemoteLinkUpdate,
+		DeleteContext: resourceIssueRemoteLinkDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceIssueRemoteLinkImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"issue_key": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"url": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"title": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"summary": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"global_id": {
+				Description: "Identifies the link globally. Links sharing a global_id are updated instead of duplicated.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"relationship": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"application_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"application_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"icon_url": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"icon_title": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"resolved": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"status_icon_url": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"status_icon_title": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			// Computed values
+			"link_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func setRemoteLink(w *RemoteLink, d *schema.ResourceData) {
+	w.GlobalID = d.Get("global_id").(string)
+	w.Relationship = d.Get("relationship").(string)
+
+	if applicationType, applicationName := d.Get("application_type").(string), d.Get("application_name").(string); applicationType != "" || applicationName != "" {
+		w.Application = &RemoteLinkApplication{
+			Type: applicationType,
+			Name: applicationName,
+		}
+	}
+
+	w.Object = RemoteLinkObject{
+		URL:     d.Get("url").(string),
+		Title:   d.Get("title").(string),
+		Summary: d.Get("summary").(string),
+	}
+
+	if iconURL, iconTitle := d.Get("icon_url").(string), d.Get("icon_title").(string); iconURL != "" || iconTitle != "" {
+		w.Object.Icon = &RemoteLinkIcon{
+			URL16x16: iconURL,
+			Title:    iconTitle,
+		}
+	}
+
+	w.Object.Status = &RemoteLinkStatus{
+		Resolved: d.Get("resolved").(bool),
+	}
+
+	if iconURL, iconTitle := d.Get("status_icon_url").(string), d.Get("status_icon_title").(string); iconURL != "" || iconTitle != "" {
+		w.Object.Status.Icon = &RemoteLinkIcon{
+			URL16x16: iconURL,
+			Title:    iconTitle,
+		}
+	}
+}
+
+func setRemoteLinkResource(w *RemoteLink, d *schema.ResourceData) {
+	d.Set("link_id", w.ID)
+	d.Set("url", w.Object.URL)
+	d.Set("title", w.Object.Title)
+	d.Set("summary", w.Object.Summary)
+	d.Set("global_id", w.GlobalID)
+	d.Set("relationship", w.Relationship)
+
+	d.Set("application_type", "")
+	d.Set("application_name", "")
+	if w.Application != nil {
+		d.Set("application_type", w.Application.Type)
+		d.Set("application_name", w.Application.Name)
+	}
+
+	d.Set("icon_url", "")
+	d.Set("icon_title", "")
+	if w.Object.Icon != nil {
+		d.Set("icon_url", w.Object.Icon.URL16x16)
+		d.Set("icon_title", w.Object.Icon.Title)
+	}
+
+	d.Set("resolved", false)
+	d.Set("status_icon_url", "")
+	d.Set("status_icon_title", "")
+	if w.Object.Status != nil {
+		d.Set("resolved", w.Object.Status.Resolved)
+		if w.Object.Status.Icon != nil {
+			d.Set("status_icon_url", w.Object.Status.Icon.URL16x16)
+			d.Set("status_icon_title", w.Object.Status.Icon.Title)
+		}
+	}
+}
+
+// resourceIssueRemoteLinkCreate creates a new remote link using the jira api.
+// If a link with the same global_id already exists, Jira updates it in place.
+func resourceIssueRemoteLinkCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*Config)
+	issueKey := d.Get("issue_key").(string)
+
+	remoteLink := new(RemoteLink)
+	returnedRemoteLink := new(RemoteLink)
+
+	setRemoteLink(remoteLink, d)
+
+	_, err := requestWithContext(ctx, config.jiraClient, "POST", issueRemoteLinkAPIEndpoint(issueKey), remoteLink, returnedRemoteLink)
+	if err != nil {
+		return diag.FromErr(errors.Wrap(err, "creating remote link failed"))
+	}
+
+	d.SetId(fmt.Sprintf("%s/%d", issueKey, returnedRemoteLink.ID))
+
+	return resourceIssueRemoteLinkRead(ctx, d, m)
+}
+
+// resourceIssueRemoteLinkRead reads remote link details using jira api
+func resourceIssueRemoteLinkRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*Config)
+
+	issueKey, linkID, err := parseIssueRemoteLinkID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	urlStr := fmt.Sprintf("%s/%d", issueRemoteLinkAPIEndpoint(issueKey), linkID)
+
+	remoteLink := new(RemoteLink)
+	res, err := requestWithContext(ctx, config.jiraClient, "GET", urlStr, nil, remoteLink)
+	if err != nil {
+		if isNotFound(res) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(errors.Wrap(err, "getting remote link failed"))
+	}
+
+	d.Set("issue_key", issueKey)
+	setRemoteLinkResource(remoteLink, d)
+
+	return nil
+}
+
+// resourceIssueRemoteLinkUpdate updates remote link using jira api
+func resourceIssueRemoteLinkUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*Config)
+
+	issueKey, linkID, err := parseIssueRemoteLinkID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	remoteLink := new(RemoteLink)
+	setRemoteLink(remoteLink, d)
+
+	urlStr := fmt.Sprintf("%s/%d", issueRemoteLinkAPIEndpoint(issueKey), linkID)
+
+	_, err = requestWithContext(ctx, config.jiraClient, "PUT", urlStr, remoteLink, nil)
+	if err != nil {
+		return diag.FromErr(errors.Wrap(err, "updating remote link failed"))
+	}
+
+	return resourceIssueRemoteLinkRead(ctx, d, m)
+}
+
+// resourceIssueRemoteLinkDelete deletes remote link using the jira api
+func resourceIssueRemoteLinkDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*Config)
+
+	issueKey, linkID, err := parseIssueRemoteLinkID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	urlStr := fmt.Sprintf("%s/%d", issueRemoteLinkAPIEndpoint(issueKey), linkID)
+
+	res, err := requestWithContext(ctx, config.jiraClient, "DELETE", urlStr, nil, nil)
+	if err != nil && !isNotFound(res) {
+		return diag.FromErr(errors.Wrap(err, "deleting remote link failed"))
+	}
+
+	return nil
+}
+
+// resourceIssueRemoteLinkImport imports a remote link by <issue_key>/<link_id>
+func resourceIssueRemoteLinkImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	issueKey, _, err := parseIssueRemoteLinkID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("issue_key", issueKey)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func parseIssueRemoteLinkID(id string) (string, int, error) {
+	components := strings.SplitN(id, "/", 2)
+	if len(components) != 2 {
+		return "", 0, fmt.Errorf("remote link id must be formatted as <issue_key>/<link_id>, got %q", id)
+	}
+
+	linkID, err := strconv.Atoi(components[1])
+	if err != nil {
+		return "", 0, errors.Wrapf(err, "remote link id must be formatted as <issue_key>/<link_id>, got %q", id)
+	}
+
+	return components[0], linkID, nil
+}
@@ -0,0 +1,120 @@
+package jira
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccJiraIssueRemoteLink_basic(t *testing.T) {
+	rInt := acctest.RandInt()
+	resourceName := "jira_issue_remote_link.runbook"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckJiraIssueRemoteLinkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJiraIssueRemoteLinkConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJiraIssueRemoteLinkExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "title", "Runbook"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckJiraIssueRemoteLinkDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*Config).jiraClient
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "jira_issue_remote_link" {
+			continue
+		}
+
+		issueKey, linkID, err := parseIssueRemoteLinkID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		req, _ := client.NewRequest("GET", fmt.Sprintf("%s/%d", issueRemoteLinkAPIEndpoint(issueKey), linkID), nil)
+		resp, _ := client.Do(req, nil)
+
+		if resp.StatusCode != 404 {
+			return fmt.Errorf("Remote link %q still exists", rs.Primary.ID)
+		}
+		return nil
+	}
+	return nil
+}
+
+func testAccCheckJiraIssueRemoteLinkExists(n string) resource.TestCheckFunc {
+
+	return func(s *terraform.State) error {
+
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not Found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No remote link ID is set")
+		}
+
+		issueKey, linkID, err := parseIssueRemoteLinkID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*Config).jiraClient
+		req, _ := client.NewRequest("GET", fmt.Sprintf("%s/%d", issueRemoteLinkAPIEndpoint(issueKey), linkID), nil)
+		resp, _ := client.Do(req, nil)
+
+		if resp.StatusCode != 200 {
+			return fmt.Errorf("Remote link %q does not exists", rs.Primary.ID)
+		}
+		return nil
+	}
+
+}
+
+func testAccJiraIssueRemoteLinkConfig(rInt int) string {
+	return fmt.Sprintf(`
+resource "jira_user" "foo" {
+	name = "project-user-%d"
+	email = "example@example.org"
+}
+
+resource "jira_project" "foo" {
+  name = "foo-name-%d"
+  key = "PX%d"
+  lead = "${jira_user.foo.name}"
+  project_type_key = "business"
+  project_template_key = "com.atlassian.jira-core-project-templates:jira-core-project-management"
+}
+
+resource "jira_issue" "example" {
+	issue_type    = "Task"
+	project_key   = "${jira_project.foo.key}"
+	summary       = "Created using Terraform"
+}
+
+resource "jira_issue_remote_link" "runbook" {
+	issue_key    = "${jira_issue.example.issue_key}"
+	url          = "https://example.org/runbooks/%d"
+	title        = "Runbook"
+	global_id    = "runbook-%d"
+	relationship = "documented by"
+}
+`, rInt, rInt, rInt%100000, rInt, rInt)
+}
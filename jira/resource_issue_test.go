@@ -1,6 +1,7 @@
 package jira
 
 import (
+	"encoding/json"
 	"fmt"
 	"testing"
 
@@ -131,3 +132,40 @@ resource "jira_issue" "example" {
 }
 `, rInt, rInt, rInt%100000)
 }
+
+func TestValidateIssueFields(t *testing.T) {
+	metaFields := map[string]MetaField{
+		"summary":           {Required: true, Name: "Summary"},
+		"reporter":          {Required: true, Name: "Reporter", HasDefaultValue: true},
+		"duedate":           {Required: true, Name: "Due date"},
+		"priority":          {Name: "Priority", AllowedValues: []json.RawMessage{json.RawMessage(`{"id": "1", "name": "High"}`), json.RawMessage(`{"id": "2", "name": "Low"}`)}},
+		"customfield_10001": {Name: "Colors", AllowedValues: []json.RawMessage{json.RawMessage(`{"id": "10100", "value": "Red"}`), json.RawMessage(`{"id": "10101", "value": "Blue"}`)}},
+	}
+
+	cases := []struct {
+		fields        map[string]interface{}
+		checkRequired bool
+		expected      string
+	}{
+		{map[string]interface{}{"duedate": "2021-01-01"}, true, ""},
+		{map[string]interface{}{}, true, "invalid issue fields\nfields.duedate: Due date is required"},
+		{map[string]interface{}{}, false, ""},
+		{map[string]interface{}{"priority": `{"name": "high"}`}, false, ""},
+		{map[string]interface{}{"priority": `{"id": "2"}`}, false, ""},
+		{map[string]interface{}{"customfield_10001": `[{"value": "Red"}, {"id": "10101"}]`}, false, ""},
+		{map[string]interface{}{"customfield_10001": `[{"value": "Green"}]`}, false, "invalid issue fields\nfields.customfield_10001: Green is not allowed for Colors, allowed values: Red, Blue"},
+		{map[string]interface{}{"Colors": "Red"}, false, "invalid issue fields\nfields.Colors: fields are configured by ID, use customfield_10001 instead"},
+		{map[string]interface{}{"customfield_99999": "x"}, false, "invalid issue fields\nfields.customfield_99999: field does not exist or is not on the screen"},
+	}
+
+	for _, c := range cases {
+		err := validateIssueFields(metaFields, c.fields, map[string]bool{"summary": true}, c.checkRequired)
+		message := ""
+		if err != nil {
+			message = err.Error()
+		}
+		if message != c.expected {
+			t.Errorf("validateIssueFields(%v) = %q, expected %q", c.fields, message, c.expected)
+		}
+	}
+}
@@ -0,0 +1,168 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/andygrunwald/go-jira"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+)
+
+// resourceIssueTransition is used to transition an existing JIRA issue once
+func resourceIssueTransition() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIssueTransitionCreate,
+		ReadContext:   resourceIssueTransitionRead,
+		DeleteContext: resourceIssueTransitionDelete,
+
+		Schema: map[string]*schema.Schema{
+			"issue_key": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"target_status": {
+				Description:  "Name or ID of the status to move the issue to. The matching transition is looked up.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"target_status", "transition_id"},
+			},
+			"transition_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"target_status", "transition_id"},
+			},
+			"fields": {
+				Description: "Fields to set during the transition. Values which are valid JSON are sent decoded.",
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Elem: &schema.Schema{
+					Type:     schema.TypeString,
+					Required: true,
+				},
+			},
+			"triggers": {
+				Description: "Arbitrary values which cause the transition to run again when changed.",
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			// Computed values
+			"status_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// findTransition returns the transition leading to the status with the given name or ID
+func findTransition(transitions []jira.Transition, status string) *jira.Transition {
+	for _, transition := range transitions {
+		if transition.To.ID == status || strings.EqualFold(transition.To.Name, status) {
+			return &transition
+		}
+	}
+	return nil
+}
+
+// findTransitionByID returns the transition with the given ID
+func findTransitionByID(transitions []jira.Transition, id string) *jira.Transition {
+	for _, transition := range transitions {
+		if transition.ID == id {
+			return &transition
+		}
+	}
+	return nil
+}
+
+// resourceIssueTransitionCreate transitions the issue using the jira api
+func resourceIssueTransitionCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*Config)
+	issueKey := d.Get("issue_key").(string)
+
+	issue, _, err := config.jiraClient.Issue.GetWithContext(ctx, issueKey, &jira.GetQueryOptions{Fields: "status"})
+	if err != nil {
+		return diag.FromErr(errors.Wrap(err, "getting jira issue failed"))
+	}
+
+	targetStatus, byStatus := d.GetOk("target_status")
+	if byStatus && (issue.Fields.Status.ID == targetStatus.(string) || strings.EqualFold(issue.Fields.Status.Name, targetStatus.(string))) {
+		// Already there, nothing to do
+		d.SetId(fmt.Sprintf("%s/%s", issueKey, issue.Fields.Status.ID))
+		return resourceIssueTransitionRead(ctx, d, m)
+	}
+
+	transitions, _, err := config.jiraClient.Issue.GetTransitionsWithContext(ctx, issueKey)
+	if err != nil {
+		return diag.FromErr(errors.Wrap(err, "getting transitions of jira issue failed"))
+	}
+
+	var transition *jira.Transition
+	target := d.Get("target_status").(string)
+	if byStatus {
+		transition = findTransition(transitions, target)
+	} else {
+		transition = findTransitionByID(transitions, d.Get("transition_id").(string))
+		target = fmt.Sprintf("transition %s", d.Get("transition_id"))
+	}
+
+	if transition == nil {
+		names := []string{}
+		for _, t := range transitions {
+			names = append(names, fmt.Sprintf("%s (to %s)", t.Name, t.To.Name))
+		}
+		return diag.Errorf("issue %s cannot be transitioned from %s to %s, available transitions: %s",
+			issueKey, issue.Fields.Status.Name, target, strings.Join(names, ", "))
+	}
+
+	fields := &jira.IssueFields{}
+	if err := setIssueUnknowns(fields, d.Get("fields").(map[string]interface{})); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := transitionIssue(ctx, config.jiraClient, issueKey, transition.ID, fields.Unknowns); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The id names the target status, however the transition has been chosen
+	d.SetId(fmt.Sprintf("%s/%s", issueKey, transition.To.ID))
+
+	return resourceIssueTransitionRead(ctx, d, m)
+}
+
+// resourceIssueTransitionRead reads the current status of the issue using jira api
+func resourceIssueTransitionRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*Config)
+
+	issue, res, err := config.jiraClient.Issue.GetWithContext(ctx, d.Get("issue_key").(string), &jira.GetQueryOptions{Fields: "status"})
+	if err != nil {
+		if isNotFound(res) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(errors.Wrap(err, "getting jira issue failed"))
+	}
+
+	d.Set("status_id", issue.Fields.Status.ID)
+	d.Set("status_name", issue.Fields.Status.Name)
+
+	return nil
+}
+
+// resourceIssueTransitionDelete only removes the transition from the state,
+// the issue stays in its current status
+func resourceIssueTransitionDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return nil
+}
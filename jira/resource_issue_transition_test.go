@@ -0,0 +1,39 @@
+package jira
+
+import (
+	"testing"
+
+	"github.com/andygrunwald/go-jira"
+)
+
+func TestFindTransition(t *testing.T) {
+	transitions := []jira.Transition{
+		{ID: "11", Name: "Start", To: jira.Status{ID: "3", Name: "In Progress"}},
+		{ID: "21", Name: "Approve", To: jira.Status{ID: "10001", Name: "Approved"}},
+	}
+
+	cases := []struct {
+		status string
+		id     string
+	}{
+		{"Approved", "21"},
+		{"in progress", "11"},
+		{"10001", "21"},
+		{"Approve", ""},
+		{"Done", ""},
+	}
+
+	for _, c := range cases {
+		transition := findTransition(transitions, c.status)
+		if c.id == "" && transition != nil || c.id != "" && (transition == nil || transition.ID != c.id) {
+			t.Errorf("findTransition(%q) = %v, want transition %q", c.status, transition, c.id)
+		}
+	}
+
+	if transition := findTransitionByID(transitions, "21"); transition == nil || transition.To.ID != "10001" {
+		t.Errorf("findTransitionByID(%q) = %v", "21", transition)
+	}
+	if transition := findTransitionByID(transitions, "31"); transition != nil {
+		t.Errorf("findTransitionByID(%q) = %v, want nil", "31", transition)
+	}
+}
@@ -15,6 +15,39 @@ type IssueTypeRequest struct {
 	Name        string `json:"name,omitempty" structs:"name,omitempty"`
 	Type        string `json:"type,omitempty" structs:"type,omitempty"`
 	AvatarID    int    `json:"avatarId,omitempty" structs:"avatarId,omitempty"`
+	Scope       *Scope `json:"scope,omitempty" structs:"scope,omitempty"`
+}
+
+// Scope limits an issue type or field to a team-managed project
+type Scope struct {
+	Type    string `json:"type"`
+	Project struct {
+		ID string `json:"id"`
+	} `json:"project"`
+}
+
+// IssueTypeResponse is returned by JIRA for a single issue type, including its scope
+type IssueTypeResponse struct {
+	jira.IssueType
+	Scope *Scope `json:"scope,omitempty"`
+}
+
+// scopedIssueTypeAPIEndpoint creates issue types of team-managed projects
+const scopedIssueTypeAPIEndpoint = "/rest/api/3/issuetype"
+
+// projectScope returns the scope of a team-managed project
+func projectScope(projectID string) *Scope {
+	scope := &Scope{Type: "PROJECT"}
+	scope.Project.ID = projectID
+	return scope
+}
+
+// scopeProjectID returns the ID of the project a scope is limited to
+func scopeProjectID(scope *Scope) string {
+	if scope == nil || scope.Type != "PROJECT" {
+		return ""
+	}
+	return scope.Project.ID
 }
 
 func resourceIssueType() *schema.Resource {
@@ -46,6 +79,12 @@ func resourceIssueType() *schema.Resource {
 				Type:     schema.TypeInt,
 				Optional: true,
 			},
+			"project_id": {
+				Description: "ID of the team-managed project the issue type belongs to. Without it, the issue type is shared by all classic projects.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+			},
 		},
 	}
 }
@@ -64,8 +103,14 @@ func resourceIssueTypeCreate(d *schema.ResourceData, m interface{}) error {
 		issueType.Type = "subtask"
 	}
 
+	endpoint := issueTypeAPIEndpoint
+	if projectID, ok := d.GetOk("project_id"); ok {
+		issueType.Scope = projectScope(projectID.(string))
+		endpoint = scopedIssueTypeAPIEndpoint
+	}
+
 	returnedIssueType := new(jira.IssueType)
-	err := request(config.jiraClient, "POST", issueTypeAPIEndpoint, issueType, returnedIssueType)
+	err := request(config.jiraClient, "POST", endpoint, issueType, returnedIssueType)
 	if err != nil {
 		return errors.Wrap(err, "Request failed")
 	}
@@ -83,7 +128,7 @@ func resourceIssueTypeRead(d *schema.ResourceData, m interface{}) error {
 
 	urlStr := fmt.Sprintf("%s/%s", issueTypeAPIEndpoint, d.Id())
 
-	issueType := new(jira.IssueType)
+	issueType := new(IssueTypeResponse)
 	err := request(config.jiraClient, "GET", urlStr, nil, issueType)
 
 	if err != nil {
@@ -94,6 +139,7 @@ func resourceIssueTypeRead(d *schema.ResourceData, m interface{}) error {
 	d.Set("description", issueType.Description)
 	d.Set("is_subtask", issueType.Subtask)
 	d.Set("avatar_id", issueType.AvatarID)
+	d.Set("project_id", scopeProjectID(issueType.Scope))
 
 	return nil
 }
@@ -0,0 +1,605 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/andygrunwald/go-jira"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+)
+
+const issueBulkAPIEndpoint = "/rest/api/2/issue/bulk"
+
+// issueBulkChunkSize is the maximum number of issues JIRA creates in one request
+const issueBulkChunkSize = 50
+
+// IssueBulkUpdate is a single issue within a bulk create request
+type IssueBulkUpdate struct {
+	Fields *jira.IssueFields `json:"fields"`
+}
+
+// IssueBulkRequest is sent to JIRA to create several issues at once
+type IssueBulkRequest struct {
+	IssueUpdates []IssueBulkUpdate `json:"issueUpdates"`
+}
+
+// IssueBulkError describes why a single issue of a bulk create request failed
+type IssueBulkError struct {
+	Status              int `json:"status"`
+	FailedElementNumber int `json:"failedElementNumber"`
+	ElementErrors       struct {
+		ErrorMessages []string          `json:"errorMessages"`
+		Errors        map[string]string `json:"errors"`
+	} `json:"elementErrors"`
+}
+
+// IssueBulkResponse is returned by JIRA after a bulk create request
+type IssueBulkResponse struct {
+	Issues []jira.Issue     `json:"issues"`
+	Errors []IssueBulkError `json:"errors"`
+}
+
+// resourceIssues is used to define many JIRA issues at once
+func resourceIssues() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIssuesCreate,
+		ReadContext:   resourceIssuesRead,
+		UpdateContext: resourceIssuesUpdate,
+		DeleteContext: resourceIssuesDelete,
+		CustomizeDiff: resourceIssuesCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"issue": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Set:      resourceIssuesIssueHash,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Description: "Stable identifier of the issue within this resource.",
+							Type:        schema.TypeString,
+							Required:    true,
+						},
+						"assignee": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							DiffSuppressFunc: caseInsensitiveSuppressFunc,
+						},
+						"reporter": {
+							Type:     schema.TypeString,
+							Optional: true,
+							DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+								if new == "" {
+									return true
+								}
+								return caseInsensitiveSuppressFunc(k, old, new, d)
+							},
+						},
+						"fields": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:     schema.TypeString,
+								Required: true,
+							},
+						},
+						"issue_type": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "",
+						},
+						"labels": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"summary": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"project_key": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"parent": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						// Computed values
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"issue_key": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			// Computed values
+			"issue_keys": {
+				Description: "Issue keys by the key of the issue within this resource.",
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+// resourceIssuesIssueHash identifies issue blocks by their key, so changing a
+// block shows up as a change of that issue
+func resourceIssuesIssueHash(v interface{}) int {
+	return schema.HashString(v.(map[string]interface{})["key"])
+}
+
+// resourceIssuesCustomizeDiff rejects issue blocks sharing a key, as the key
+// identifies which issue a block belongs to. Such blocks share a hash and are
+// merged in the set, so the keys are read from the raw configuration.
+func resourceIssuesCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	raw := d.GetRawConfig()
+	if raw.IsNull() || !raw.GetAttr("issue").IsKnown() || raw.GetAttr("issue").IsNull() {
+		return nil
+	}
+
+	keys := []string{}
+	for it := raw.GetAttr("issue").ElementIterator(); it.Next(); {
+		_, block := it.Element()
+		if key := block.GetAttr("key"); key.IsKnown() && !key.IsNull() {
+			keys = append(keys, key.AsString())
+		}
+	}
+
+	if duplicates := duplicateIssueKeys(keys); len(duplicates) > 0 {
+		return fmt.Errorf("the key of every issue must be unique, found duplicates: %s", strings.Join(duplicates, ", "))
+	}
+	return nil
+}
+
+// duplicateIssueKeys returns the keys used by more than one issue block
+func duplicateIssueKeys(keys []string) []string {
+	seen := map[string]int{}
+	duplicates := []string{}
+
+	for _, key := range keys {
+		seen[key]++
+		if seen[key] == 2 {
+			duplicates = append(duplicates, key)
+		}
+	}
+
+	return duplicates
+}
+
+// issueFromSpec builds the fields of a new issue from one issue block
+func issueFromSpec(spec map[string]interface{}) (*jira.IssueFields, error) {
+	fields := &jira.IssueFields{
+		Description: spec["description"].(string),
+		Type: jira.IssueType{
+			Name: spec["issue_type"].(string),
+		},
+		Project: jira.Project{
+			Key: spec["project_key"].(string),
+		},
+		Summary: spec["summary"].(string),
+	}
+
+	if assignee := spec["assignee"].(string); assignee != "" {
+		fields.Assignee = &jira.User{Name: assignee}
+	}
+
+	if reporter := spec["reporter"].(string); reporter != "" {
+		fields.Reporter = &jira.User{Name: reporter}
+	}
+
+	for _, label := range spec["labels"].([]interface{}) {
+		fields.Labels = append(fields.Labels, fmt.Sprintf("%v", label))
+	}
+
+	if err := setIssueUnknowns(fields, spec["fields"].(map[string]interface{})); err != nil {
+		return nil, err
+	}
+
+	if parent := spec["parent"].(string); parent != "" {
+		fields.Unknowns.Set("parent", issueParent(parent))
+	}
+
+	return fields, nil
+}
+
+// issueUpdateFromSpec builds the fields of an update of an existing issue.
+// Empty values are left out when creating issues, so they are cleared explicitly.
+func issueUpdateFromSpec(spec map[string]interface{}) (*jira.IssueFields, error) {
+	fields, err := issueFromSpec(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	fields.Type = jira.IssueType{}
+	fields.Project = jira.Project{}
+
+	// MarshalMap.Set removes keys set to nil, so the map is written directly
+	if fields.Assignee == nil {
+		fields.Unknowns["assignee"] = nil
+	}
+	if len(fields.Labels) == 0 {
+		fields.Unknowns["labels"] = []string{}
+	}
+
+	return fields, nil
+}
+
+// createIssuesInBulk creates the given issue blocks in chunks and records the
+// id and key of every created issue in the block. Failed issues are reported
+// as separate diagnostics.
+func createIssuesInBulk(ctx context.Context, config *Config, specs []map[string]interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for start := 0; start < len(specs); start += issueBulkChunkSize {
+		end := start + issueBulkChunkSize
+		if end > len(specs) {
+			end = len(specs)
+		}
+		chunk := specs[start:end]
+
+		bulkRequest := IssueBulkRequest{}
+		for _, spec := range chunk {
+			fields, err := issueFromSpec(spec)
+			if err != nil {
+				return append(diags, diag.FromErr(err)...)
+			}
+			bulkRequest.IssueUpdates = append(bulkRequest.IssueUpdates, IssueBulkUpdate{Fields: fields})
+		}
+
+		req, err := config.jiraClient.NewRequestWithContext(ctx, "POST", issueBulkAPIEndpoint, bulkRequest)
+		if err != nil {
+			return append(diags, diag.FromErr(errors.Wrap(err, "Creating POST Request failed"))...)
+		}
+
+		bulkResponse := new(IssueBulkResponse)
+		res, err := config.jiraClient.Do(req, bulkResponse)
+		if err != nil {
+			// A partially failed request is answered with 400, listing the
+			// created issues next to the errors
+			if res == nil || res.StatusCode != http.StatusBadRequest {
+				return append(diags, diag.FromErr(jira.NewJiraError(res, err))...)
+			}
+			decodeErr := json.NewDecoder(res.Body).Decode(bulkResponse)
+			res.Body.Close()
+			if decodeErr != nil || len(bulkResponse.Errors) == 0 {
+				return append(diags, diag.FromErr(errors.Wrap(err, "creating jira issues failed"))...)
+			}
+		}
+
+		diags = append(diags, applyIssueBulkResponse(chunk, bulkResponse)...)
+	}
+
+	return diags
+}
+
+// applyIssueBulkResponse records the created issues in their blocks and
+// turns the errors into diagnostics naming the failed blocks
+func applyIssueBulkResponse(chunk []map[string]interface{}, bulkResponse *IssueBulkResponse) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	failed := map[int]bool{}
+	for _, bulkError := range bulkResponse.Errors {
+		failed[bulkError.FailedElementNumber] = true
+
+		messages := bulkError.ElementErrors.ErrorMessages
+		for field, message := range bulkError.ElementErrors.Errors {
+			messages = append(messages, fmt.Sprintf("%s: %s", field, message))
+		}
+		sort.Strings(messages)
+
+		key := "unknown"
+		if bulkError.FailedElementNumber >= 0 && bulkError.FailedElementNumber < len(chunk) {
+			key = chunk[bulkError.FailedElementNumber]["key"].(string)
+		}
+
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("creating jira issue %q failed", key),
+			Detail:   strings.Join(messages, "\n"),
+		})
+	}
+
+	// JIRA returns the created issues in request order, skipping failed ones
+	created := 0
+	for i, spec := range chunk {
+		if failed[i] || created >= len(bulkResponse.Issues) {
+			continue
+		}
+		spec["id"] = bulkResponse.Issues[created].ID
+		spec["issue_key"] = bulkResponse.Issues[created].Key
+		created++
+	}
+
+	return diags
+}
+
+// issueSpecs returns the configured issue blocks
+func issueSpecs(raw interface{}) []map[string]interface{} {
+	specs := []map[string]interface{}{}
+	for _, spec := range raw.(*schema.Set).List() {
+		specs = append(specs, spec.(map[string]interface{}))
+	}
+	return specs
+}
+
+// setIssueSpecs stores the issue blocks which have been created in the state
+func setIssueSpecs(d *schema.ResourceData, specs []map[string]interface{}) {
+	issues := []interface{}{}
+	issueKeys := map[string]string{}
+
+	for _, spec := range specs {
+		if id, ok := spec["id"].(string); ok && id != "" {
+			issues = append(issues, spec)
+			issueKeys[spec["key"].(string)] = spec["issue_key"].(string)
+		}
+	}
+
+	d.Set("issue", issues)
+	d.Set("issue_keys", issueKeys)
+}
+
+// resourceIssuesCreate creates the jira issues using the bulk api
+func resourceIssuesCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*Config)
+
+	specs := issueSpecs(d.Get("issue"))
+
+	diags := createIssuesInBulk(ctx, config, specs)
+
+	created := false
+	for _, spec := range specs {
+		if id, _ := spec["id"].(string); id != "" {
+			created = true
+		}
+	}
+
+	// Returning errors after the id has been set would taint the resource and
+	// recreate the issues which have been created
+	if !created {
+		return diags
+	}
+
+	d.SetId(resource.UniqueId())
+	setIssueSpecs(d, specs)
+
+	// Failed issues are left out of the state and created on the next apply
+	return append(diagnosticsAsWarnings(diags), resourceIssuesRead(ctx, d, m)...)
+}
+
+// diagnosticsAsWarnings downgrades errors to warnings
+func diagnosticsAsWarnings(diags diag.Diagnostics) diag.Diagnostics {
+	warnings := diag.Diagnostics{}
+	for _, d := range diags {
+		d.Severity = diag.Warning
+		warnings = append(warnings, d)
+	}
+	return warnings
+}
+
+// resourceIssuesRead reads the details of all issues using jira api
+func resourceIssuesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*Config)
+
+	specs := issueSpecs(d.Get("issue"))
+	issues := map[string]jira.Issue{}
+
+	for start := 0; start < len(specs); start += issueBulkChunkSize {
+		end := start + issueBulkChunkSize
+		if end > len(specs) {
+			end = len(specs)
+		}
+
+		ids := []string{}
+		for _, spec := range specs[start:end] {
+			ids = append(ids, spec["id"].(string))
+		}
+
+		jql := fmt.Sprintf("id in (%s)", strings.Join(ids, ","))
+		options := &jira.SearchOptions{MaxResults: issueBulkChunkSize, Fields: []string{"*all"}}
+
+		err := config.jiraClient.Issue.SearchPagesWithContext(ctx, jql, options, func(issue jira.Issue) error {
+			issues[issue.ID] = issue
+			return nil
+		})
+		if err != nil {
+			return diag.FromErr(errors.Wrap(err, "searching jira issues failed"))
+		}
+	}
+
+	remaining := []map[string]interface{}{}
+	for _, spec := range specs {
+		issue, ok := issues[spec["id"].(string)]
+		if !ok {
+			// The issue has been deleted outside of terraform
+			continue
+		}
+
+		spec["issue_key"] = issue.Key
+		spec["issue_type"] = issue.Fields.Type.Name
+		spec["project_key"] = issue.Fields.Project.Key
+		spec["summary"] = issue.Fields.Summary
+		spec["description"] = issue.Fields.Description
+		spec["labels"] = issue.Fields.Labels
+
+		spec["assignee"] = ""
+		if issue.Fields.Assignee != nil {
+			spec["assignee"] = issue.Fields.Assignee.Name
+		}
+
+		if issue.Fields.Reporter != nil {
+			spec["reporter"] = issue.Fields.Reporter.Name
+		}
+
+		spec["parent"] = ""
+		if issue.Fields.Parent != nil {
+			spec["parent"] = issue.Fields.Parent.Key
+		}
+
+		fields, err := getIssueUnknowns(&issue, spec["fields"].(map[string]interface{}))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		spec["fields"] = fields
+
+		remaining = append(remaining, spec)
+	}
+
+	setIssueSpecs(d, remaining)
+
+	return nil
+}
+
+// resourceIssuesUpdate creates, updates and deletes only those issues whose
+// block has been added, changed or removed
+func resourceIssuesUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	config := m.(*Config)
+
+	o, n := d.GetChange("issue")
+
+	existing := map[string]map[string]interface{}{}
+	for _, spec := range issueSpecs(o) {
+		existing[spec["key"].(string)] = spec
+	}
+
+	specs := issueSpecs(n)
+	toCreate := []map[string]interface{}{}
+	replaced := map[int]map[string]interface{}{}
+
+	for index, spec := range specs {
+		key := spec["key"].(string)
+		old, ok := existing[key]
+		delete(existing, key)
+
+		// Moving issues is not supported in bulk, those are recreated instead.
+		// The old issue is only deleted once its replacement exists.
+		if !ok || old["project_key"] != spec["project_key"] || !strings.EqualFold(old["issue_type"].(string), spec["issue_type"].(string)) {
+			if ok {
+				replaced[index] = old
+			}
+			toCreate = append(toCreate, spec)
+			continue
+		}
+
+		spec["id"] = old["id"]
+		spec["issue_key"] = old["issue_key"]
+
+		if issueSpecEqual(old, spec) {
+			continue
+		}
+
+		fields, err := issueUpdateFromSpec(spec)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		i := jira.Issue{
+			ID:     old["id"].(string),
+			Key:    old["id"].(string),
+			Fields: fields,
+		}
+
+		_, res, err := config.jiraClient.Issue.UpdateWithContext(ctx, &i)
+		if err != nil {
+			if isNotFound(res) {
+				toCreate = append(toCreate, spec)
+				continue
+			}
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("updating jira issue %q failed", key),
+				Detail:   err.Error(),
+			})
+			// Keep the last known values in the state
+			specs[index] = old
+		}
+	}
+
+	for _, old := range existing {
+		if err := deleteBulkIssue(ctx, config, old); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	for _, spec := range toCreate {
+		spec["id"] = ""
+		spec["issue_key"] = ""
+	}
+
+	diags = append(diags, createIssuesInBulk(ctx, config, toCreate)...)
+
+	for index, old := range replaced {
+		if id, _ := specs[index]["id"].(string); id == "" {
+			// Keep the old issue as its replacement could not be created
+			specs[index] = old
+			continue
+		}
+		if err := deleteBulkIssue(ctx, config, old); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+	}
+
+	setIssueSpecs(d, specs)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	return resourceIssuesRead(ctx, d, m)
+}
+
+// resourceIssuesDelete deletes all issues using the jira api
+func resourceIssuesDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*Config)
+
+	for _, spec := range issueSpecs(d.Get("issue")) {
+		if err := deleteBulkIssue(ctx, config, spec); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+func deleteBulkIssue(ctx context.Context, config *Config, spec map[string]interface{}) error {
+	id, _ := spec["id"].(string)
+	if id == "" {
+		return nil
+	}
+
+	res, err := config.jiraClient.Issue.DeleteWithContext(ctx, id)
+	if err != nil && !isNotFound(res) {
+		return errors.Wrapf(err, "deleting jira issue %q failed", spec["key"])
+	}
+
+	return nil
+}
+
+// issueSpecEqual reports whether two issue blocks configure the same values
+func issueSpecEqual(a map[string]interface{}, b map[string]interface{}) bool {
+	for _, attribute := range []string{"summary", "description", "assignee", "reporter", "parent", "labels", "fields"} {
+		if !reflect.DeepEqual(a[attribute], b[attribute]) {
+			return false
+		}
+	}
+	return true
+}
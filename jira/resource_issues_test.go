@@ -0,0 +1,148 @@
+package jira
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/andygrunwald/go-jira"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+func testIssueSpec(key string, summary string) map[string]interface{} {
+	return map[string]interface{}{
+		"key":         key,
+		"summary":     summary,
+		"description": "",
+		"assignee":    "",
+		"reporter":    "",
+		"parent":      "",
+		"issue_type":  "Task",
+		"project_key": "TEST",
+		"labels":      []interface{}{},
+		"fields":      map[string]interface{}{},
+	}
+}
+
+func TestApplyIssueBulkResponse(t *testing.T) {
+	chunk := []map[string]interface{}{
+		testIssueSpec("first", "First"),
+		testIssueSpec("second", "Second"),
+		testIssueSpec("third", "Third"),
+	}
+
+	bulkError := IssueBulkError{Status: 400, FailedElementNumber: 1}
+	bulkError.ElementErrors.Errors = map[string]string{"summary": "too long", "labels": "invalid"}
+
+	diags := applyIssueBulkResponse(chunk, &IssueBulkResponse{
+		Issues: []jira.Issue{{ID: "10001", Key: "TEST-1"}, {ID: "10003", Key: "TEST-3"}},
+		Errors: []IssueBulkError{bulkError},
+	})
+
+	if len(diags) != 1 || diags[0].Summary != `creating jira issue "second" failed` || diags[0].Detail != "labels: invalid\nsummary: too long" {
+		t.Errorf("applyIssueBulkResponse returned %v", diags)
+	}
+
+	expected := []struct{ id, issueKey interface{} }{{"10001", "TEST-1"}, {nil, nil}, {"10003", "TEST-3"}}
+	for i, e := range expected {
+		if chunk[i]["id"] != e.id || chunk[i]["issue_key"] != e.issueKey {
+			t.Errorf("block %d has id %v and issue key %v, want %v and %v", i, chunk[i]["id"], chunk[i]["issue_key"], e.id, e.issueKey)
+		}
+	}
+}
+
+func TestIssueSpecEqual(t *testing.T) {
+	a := testIssueSpec("first", "First")
+	b := testIssueSpec("first", "First")
+	b["id"] = "10001"
+
+	if !issueSpecEqual(a, b) {
+		t.Error("issueSpecEqual of blocks differing in computed values returned false")
+	}
+
+	b["labels"] = []interface{}{"docs"}
+	if issueSpecEqual(a, b) {
+		t.Error("issueSpecEqual of blocks with different labels returned true")
+	}
+
+	c := testIssueSpec("first", "Changed")
+	if issueSpecEqual(a, c) {
+		t.Error("issueSpecEqual of blocks with different summaries returned true")
+	}
+}
+
+func TestDuplicateIssueKeys(t *testing.T) {
+	keys := []string{"first", "second", "first", "first"}
+
+	if duplicates := duplicateIssueKeys(keys); !reflect.DeepEqual(duplicates, []string{"first"}) {
+		t.Errorf("duplicateIssueKeys = %v", duplicates)
+	}
+}
+
+func TestResourceIssuesIssueHash(t *testing.T) {
+	first := testIssueSpec("first", "First")
+	changed := testIssueSpec("first", "Changed")
+	second := testIssueSpec("second", "First")
+
+	if resourceIssuesIssueHash(first) != resourceIssuesIssueHash(changed) {
+		t.Error("resourceIssuesIssueHash differs for blocks with the same key")
+	}
+	if resourceIssuesIssueHash(first) == resourceIssuesIssueHash(second) {
+		t.Error("resourceIssuesIssueHash matches for blocks with different keys")
+	}
+}
+
+func TestDiagnosticsAsWarnings(t *testing.T) {
+	diags := diag.Diagnostics{
+		{Severity: diag.Error, Summary: `creating jira issue "second" failed`},
+		{Severity: diag.Warning, Summary: "deprecated"},
+	}
+
+	warnings := diagnosticsAsWarnings(diags)
+	if warnings.HasError() || len(warnings) != 2 || warnings[0].Summary != diags[0].Summary {
+		t.Errorf("diagnosticsAsWarnings = %v", warnings)
+	}
+	if diags[0].Severity != diag.Error {
+		t.Error("diagnosticsAsWarnings changed its argument")
+	}
+}
+
+func TestIssueUpdateFromSpec(t *testing.T) {
+	cases := []struct {
+		assignee string
+		labels   []interface{}
+		expected map[string]interface{}
+	}{
+		{"", []interface{}{}, map[string]interface{}{"assignee": nil, "labels": []interface{}{}}},
+		{"bot", []interface{}{"docs"}, map[string]interface{}{"labels": []interface{}{"docs"}}},
+	}
+
+	for _, c := range cases {
+		spec := testIssueSpec("first", "First")
+		spec["assignee"] = c.assignee
+		spec["labels"] = c.labels
+
+		fields, err := issueUpdateFromSpec(spec)
+		if err != nil {
+			t.Fatalf("issueUpdateFromSpec failed: %s", err)
+		}
+
+		encoded, err := json.Marshal(fields)
+		if err != nil {
+			t.Fatalf("encoding issue fields failed: %s", err)
+		}
+
+		decoded := map[string]interface{}{}
+		json.Unmarshal(encoded, &decoded)
+
+		for field, value := range c.expected {
+			if actual, ok := decoded[field]; !ok || !reflect.DeepEqual(actual, value) {
+				t.Errorf("issueUpdateFromSpec(assignee %q, labels %v) sends %s", c.assignee, c.labels, encoded)
+			}
+		}
+
+		if assignee, ok := decoded["assignee"].(map[string]interface{}); c.assignee != "" && (!ok || assignee["name"] != c.assignee) {
+			t.Errorf("issueUpdateFromSpec(assignee %q, labels %v) sends %s", c.assignee, c.labels, encoded)
+		}
+	}
+}
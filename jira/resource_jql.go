@@ -1,48 +1,354 @@
 package jira
 
 import (
-	"github.com/andygrunwald/go-jira"
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/pkg/errors"
 )
 
-// resourceComment is used to define a JIRA comment
+const jqlParseAPIEndpoint = "/rest/api/2/jql/parse?validation=strict"
+const searchAPIEndpoint = "/rest/api/2/search"
+
+// searchPageSize is the number of issues fetched per search request
+const searchPageSize = 100
+
+// JQLParseRequest is sent to JIRA to validate JQL queries
+type JQLParseRequest struct {
+	Queries []string `json:"queries"`
+}
+
+// JQLParseResult holds the outcome of validating a single JQL query
+type JQLParseResult struct {
+	Query  string   `json:"query"`
+	Errors []string `json:"errors"`
+}
+
+// JQLParseResponse is returned by JIRA after validating JQL queries
+type JQLParseResponse struct {
+	Queries []JQLParseResult `json:"queries"`
+}
+
+// SearchRequest is sent to JIRA to search for issues
+type SearchRequest struct {
+	JQL        string   `json:"jql"`
+	StartAt    int      `json:"startAt"`
+	MaxResults int      `json:"maxResults"`
+	Fields     []string `json:"fields"`
+}
+
+// SearchIssue is a single search result with its fields left undecoded
+type SearchIssue struct {
+	ID     string                     `json:"id"`
+	Key    string                     `json:"key"`
+	Fields map[string]json.RawMessage `json:"fields"`
+}
+
+// SearchResponse is returned by JIRA after searching for issues
+type SearchResponse struct {
+	StartAt    int           `json:"startAt"`
+	MaxResults int           `json:"maxResults"`
+	Total      int           `json:"total"`
+	Issues     []SearchIssue `json:"issues"`
+}
+
+// resourceJQL is used to search JIRA issues
 func resourceJQL() *schema.Resource {
 	return &schema.Resource{
-		Read: resourceJQLRead,
+		ReadContext: resourceJQLRead,
 
 		Schema: map[string]*schema.Schema{
 			"jql": {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			"order_by": {
+				Description: "Sort order of the issues, e.g. \"created DESC\". It replaces an ORDER BY clause of the JQL.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"max_results": {
+				Description: "The maximum number of issues to return. 0 returns all matches.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     0,
+			},
+			"fields": {
+				Description: "Additional fields to return for every issue.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"validate": {
+				Description: "Validate the JQL before searching.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
+			// Computed values
+			"total": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
 			"issue_keys": {
 				Type:     schema.TypeList,
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"issues": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"key": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"summary": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"fields_json": {
+							Description: "The requested fields as JSON.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
 
-func resourceJQLRead(d *schema.ResourceData, m interface{}) error {
+// validateJQL returns the errors JIRA reports for the query. Instances which
+// do not support parsing JQL report no errors.
+func validateJQL(ctx context.Context, config *Config, jql string) ([]string, error) {
+	parsed := new(JQLParseResponse)
+
+	res, err := requestWithContext(ctx, config.jiraClient, "POST", jqlParseAPIEndpoint, JQLParseRequest{Queries: []string{jql}}, parsed)
+	if err != nil {
+		if isNotFound(res) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, "validating JQL failed")
+	}
+
+	var messages []string
+	for _, query := range parsed.Queries {
+		messages = append(messages, query.Errors...)
+	}
+
+	return messages, nil
+}
+
+// jqlDiagnostics validates the query and turns every error into a diagnostic
+func jqlDiagnostics(ctx context.Context, config *Config, jql string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	messages, err := validateJQL(ctx, config, jql)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	for _, message := range messages {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Invalid JQL",
+			Detail:   fmt.Sprintf("%s\n\n%s", message, jql),
+		})
+	}
+
+	return diags
+}
+
+var jqlPositionRegexp = regexp.MustCompile(`\s*\(line (\d+), character (\d+)\)\.?$`)
+
+// jqlCustomizeDiff validates a changed JQL attribute during plan
+func jqlCustomizeDiff(attribute string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+		if !d.NewValueKnown(attribute) || (d.Id() != "" && !d.HasChange(attribute)) {
+			return nil
+		}
+
+		jql := d.Get(attribute).(string)
+		if jql == "" {
+			return nil
+		}
+
+		messages, err := validateJQL(ctx, m.(*Config), jql)
+		if err != nil {
+			return err
+		}
+
+		return jqlError(attribute, messages)
+	}
+}
+
+// jqlError combines JIRA's parse errors into one error, pointing to the line
+// and column of each problem
+func jqlError(attribute string, messages []string) error {
+	if len(messages) == 0 {
+		return nil
+	}
+
+	lines := []string{}
+	for _, message := range messages {
+		if match := jqlPositionRegexp.FindStringSubmatch(message); match != nil {
+			message = fmt.Sprintf("line %s, column %s: %s", match[1], match[2], jqlPositionRegexp.ReplaceAllString(message, ""))
+		}
+		lines = append(lines, fmt.Sprintf("%s: %s", attribute, message))
+	}
+
+	return fmt.Errorf("invalid JQL\n%s", strings.Join(lines, "\n"))
+}
+
+// searchIssues runs the query page by page and hands every issue to handler,
+// until maxResults issues have been handled. A maxResults of 0 handles all
+// matches. It returns the total number of matches.
+func searchIssues(ctx context.Context, config *Config, jql string, fields []string, maxResults int, handler func(SearchIssue) error) (int, error) {
+	search := SearchRequest{
+		JQL:        jql,
+		StartAt:    0,
+		MaxResults: searchPageSize,
+		Fields:     fields,
+	}
+
+	handled := 0
+	for {
+		if maxResults > 0 && maxResults-handled < search.MaxResults {
+			search.MaxResults = maxResults - handled
+		}
+
+		result := new(SearchResponse)
+		_, err := requestWithContext(ctx, config.jiraClient, "POST", searchAPIEndpoint, search, result)
+		if err != nil {
+			return 0, errors.Wrap(err, "searching jira issue failed")
+		}
+
+		for _, issue := range result.Issues {
+			if err := handler(issue); err != nil {
+				return 0, err
+			}
+			handled++
+		}
+
+		search.StartAt += len(result.Issues)
+
+		if len(result.Issues) == 0 || search.StartAt >= result.Total || (maxResults > 0 && handled >= maxResults) {
+			return result.Total, nil
+		}
+	}
+}
+
+var jqlOrderByExpression = regexp.MustCompile(`(?i)^order\s+by\b`)
+
+// jqlOrderByIndex returns the position of the ORDER BY clause of the query,
+// ignoring quoted text, or -1 if there is none
+func jqlOrderByIndex(jql string) int {
+	var quote rune
+	escaped := false
+
+	for i, r := range jql {
+		switch {
+		case escaped:
+			escaped = false
+		case r == '\\':
+			escaped = true
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			}
+		case r == '"' || r == '\'':
+			quote = r
+		case (i == 0 || strings.ContainsAny(jql[i-1:i], " \t\n)")) && jqlOrderByExpression.MatchString(jql[i:]):
+			return i
+		}
+	}
+
+	return -1
+}
+
+// jqlWithOrderBy sorts the query by orderBy, replacing its own ORDER BY clause
+func jqlWithOrderBy(jql string, orderBy string) string {
+	if index := jqlOrderByIndex(jql); index >= 0 {
+		jql = jql[:index]
+	}
+	return strings.TrimSpace(fmt.Sprintf("%s ORDER BY %s", strings.TrimSpace(jql), orderBy))
+}
+
+func resourceJQLRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	config := m.(*Config)
 	jql := d.Get("jql").(string)
 
+	if orderBy := d.Get("order_by").(string); orderBy != "" {
+		jql = jqlWithOrderBy(jql, orderBy)
+	}
+
+	if d.Get("validate").(bool) {
+		if diags := jqlDiagnostics(ctx, config, jql); diags.HasError() {
+			return diags
+		}
+	}
+
+	requestedFields := stringList(d.Get("fields"))
+	fields := append([]string{"summary", "status"}, requestedFields...)
+
 	var issueKeys []string
+	var issues []interface{}
 
-	handler := func(i jira.Issue) error {
+	handler := func(i SearchIssue) error {
 		issueKeys = append(issueKeys, i.Key)
+
+		var summary string
+		var status struct {
+			Name string `json:"name"`
+		}
+		json.Unmarshal(i.Fields["summary"], &summary)
+		json.Unmarshal(i.Fields["status"], &status)
+
+		selected := map[string]json.RawMessage{}
+		for _, field := range requestedFields {
+			if value, ok := i.Fields[field]; ok {
+				selected[field] = value
+			}
+		}
+		fieldsJSON, err := json.Marshal(selected)
+		if err != nil {
+			return errors.Wrap(err, "encoding issue fields failed")
+		}
+
+		issues = append(issues, map[string]interface{}{
+			"id":          i.ID,
+			"key":         i.Key,
+			"summary":     summary,
+			"status":      status.Name,
+			"fields_json": string(fieldsJSON),
+		})
 		return nil
 	}
 
-	err := config.jiraClient.Issue.SearchPages(jql, nil, handler)
+	total, err := searchIssues(ctx, config, jql, fields, d.Get("max_results").(int), handler)
 	if err != nil {
-		return errors.Wrapf(err, "searching jira issue failed")
+		return diag.FromErr(err)
 	}
 
 	d.SetId(jql)
+	d.Set("total", total)
 	d.Set("issue_keys", issueKeys)
+	d.Set("issues", issues)
 
 	return nil
 }
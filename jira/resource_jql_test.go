@@ -0,0 +1,46 @@
+package jira
+
+import (
+	"testing"
+)
+
+func TestJQLError(t *testing.T) {
+	if err := jqlError("jql", nil); err != nil {
+		t.Errorf("expected no error, got %s", err)
+	}
+
+	err := jqlError("jql", []string{
+		"Error in the JQL Query: Expecting either 'OR' or 'AND' but got 'foo'. (line 1, character 16)",
+		"Field 'bar' does not exist or you do not have permission to view it.",
+	})
+
+	expected := "invalid JQL\n" +
+		"jql: line 1, column 16: Error in the JQL Query: Expecting either 'OR' or 'AND' but got 'foo'.\n" +
+		"jql: Field 'bar' does not exist or you do not have permission to view it."
+
+	if err == nil || err.Error() != expected {
+		t.Errorf("unexpected error:\n%v\nexpected:\n%s", err, expected)
+	}
+}
+
+func TestJQLWithOrderBy(t *testing.T) {
+	cases := []struct {
+		jql      string
+		expected string
+	}{
+		{"project = TEST", "project = TEST ORDER BY created DESC"},
+		{"project = TEST ORDER BY key ASC", "project = TEST ORDER BY created DESC"},
+		{"project = TEST order  by key", "project = TEST ORDER BY created DESC"},
+		{"(project = TEST)order by key", "(project = TEST) ORDER BY created DESC"},
+		{`summary ~ "order by" AND project = TEST`, `summary ~ "order by" AND project = TEST ORDER BY created DESC`},
+		{`summary ~ 'say \'order by\'' ORDER BY key`, `summary ~ 'say \'order by\'' ORDER BY created DESC`},
+		{"labels = reorder", "labels = reorder ORDER BY created DESC"},
+		{"ORDER BY key", "ORDER BY created DESC"},
+	}
+
+	for _, c := range cases {
+		if actual := jqlWithOrderBy(c.jql, "created DESC"); actual != c.expected {
+			t.Errorf("jqlWithOrderBy(%q) = %q, want %q", c.jql, actual, c.expected)
+		}
+	}
+}
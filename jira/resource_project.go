@@ -1,11 +1,21 @@
 package jira
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"log"
+	"net/url"
+	"regexp"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/andygrunwald/go-jira"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/pkg/errors"
 )
 
@@ -31,11 +41,61 @@ type SharedConfigurationProjectResponse struct {
 	ProjectID int `json:"projectId,omitempty"`
 }
 
+// ProjectResponse is returned by JIRA for a single project, including the
+// attributes go-jira does not decode
+type ProjectResponse struct {
+	jira.Project
+	ProjectTypeKey string `json:"projectTypeKey,omitempty"`
+	Style          string `json:"style,omitempty"`
+	Archived       bool   `json:"archived,omitempty"`
+}
+
 // IDResponse The struct sent from the JIRA instance after creating a new Project
 type IDResponse struct {
 	ID int `json:"id,omitempty" structs:"id,omitempty"`
 }
 
+// getProject fetches a project by its ID or key
+func getProject(ctx context.Context, config *Config, projectIDOrKey string) (*ProjectResponse, *jira.Response, error) {
+	project := new(ProjectResponse)
+	res, err := requestWithContext(ctx, config.jiraClient, "GET", fmt.Sprintf("%s/%s", projectAPIEndpoint, projectIDOrKey), nil, project)
+	if err != nil {
+		return nil, res, errors.Wrap(err, "getting jira project failed")
+	}
+	return project, res, nil
+}
+
+// projectStyle returns whether a project is classic or team-managed.
+// Instances which do not report a style only know classic projects.
+func projectStyle(project *ProjectResponse) string {
+	if project.Style == "next-gen" {
+		return "team_managed"
+	}
+	return "classic"
+}
+
+const teamManagedProjectAPIEndpoint = "/rest/api/3/project"
+
+// teamManagedProjectTemplates are the templates used to create team-managed
+// projects of a project type if no template is configured
+var teamManagedProjectTemplates = map[string]string{
+	"software":     "com.pyxis.greenhopper.jira:gh-simplified-agility-kanban",
+	"service_desk": "com.atlassian.servicedesk:next-gen-it-service-desk",
+}
+
+// classicProjectAttributes are configured through shared schemes, which
+// team-managed projects do not use
+var classicProjectAttributes = []string{
+	"shared_configuration_project_id",
+	"issue_security_scheme",
+	"permission_scheme",
+	"notification_scheme",
+	"issue_type_scheme",
+	"issue_type_screen_scheme",
+	"field_configuration_scheme",
+	"workflow_scheme",
+}
+
 // GetJiraResourceID Fetches the ID of a JIRA resource
 func GetJiraResourceID(client *jira.Client, urlStr string) (*int, error) {
 	req, err := client.NewRequest("GET", urlStr, nil)
@@ -57,12 +117,48 @@ func GetJiraResourceID(client *jira.Client, urlStr string) (*int, error) {
 	return &response.ID, nil
 }
 
+// ProjectSchemeAssociation describes how a kind of scheme is assigned to projects
+type ProjectSchemeAssociation struct {
+	Attribute string
+	Endpoint  string
+	SchemeKey string
+	IDKey     string
+}
+
+// projectSchemeAssociations are the schemes which are looked up and assigned
+// through the scheme-project endpoints
+var projectSchemeAssociations = []ProjectSchemeAssociation{
+	{"issue_type_scheme", "/rest/api/3/issuetypescheme/project", "issueTypeScheme", "issueTypeSchemeId"},
+	{"issue_type_screen_scheme", "/rest/api/3/issuetypescreenscheme/project", "issueTypeScreenScheme", "issueTypeScreenSchemeId"},
+	{"field_configuration_scheme", "/rest/api/3/fieldconfigurationscheme/project", "fieldConfigurationScheme", "fieldConfigurationSchemeId"},
+	{"workflow_scheme", "/rest/api/3/workflowscheme/project", "workflowScheme", "workflowSchemeId"},
+}
+
+const workflowSchemeSwitchAPIEndpoint = "/rest/api/3/workflowscheme/project/switch"
+
+// WorkflowSchemeSwitchRequest is sent to JIRA to migrate the issues of a
+// project to another workflow scheme
+type WorkflowSchemeSwitchRequest struct {
+	ProjectID                   string        `json:"projectId"`
+	TargetSchemeID              string        `json:"targetSchemeId"`
+	MappingsByIssueTypeOverride []interface{} `json:"mappingsByIssueTypeOverride"`
+}
+
 func resourceProject() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceProjectCreate,
-		Read:   resourceProjectRead,
-		Update: resourceProjectUpdate,
-		Delete: resourceProjectDelete,
+		CreateContext: resourceProjectCreate,
+		ReadContext:   resourceProjectRead,
+		UpdateContext: resourceProjectUpdate,
+		DeleteContext: resourceProjectDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceProjectImport,
+		},
+		CustomizeDiff: customdiff.All(resourceProjectCustomizeDiff, resourceProjectValidateStyle),
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"project_id": {
@@ -90,6 +186,14 @@ func resourceProject() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"project_style": {
+				Description:  "classic or team_managed. Team-managed projects can only be created on JIRA Cloud.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"classic", "team_managed"}, false),
+			},
 			"description": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -131,16 +235,283 @@ func resourceProject() *schema.Resource {
 				Type:     schema.TypeInt,
 				Optional: true,
 			},
+			"issue_type_scheme": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"issue_type_screen_scheme": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"field_configuration_scheme": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"on_destroy": {
+				Description:  "What happens to the project on destroy: delete it permanently, move it to the trash (JIRA Cloud only) or archive it. Defaults to trash on JIRA Cloud and delete on JIRA Data Center.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"delete", "trash", "archive"}, false),
+			},
+			"deletion_protection": {
+				Description: "Refuse to destroy the project while it contains issues.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"restore": {
+				Description: "Restore a trashed or archived project with the same key instead of creating a new one.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"workflow_scheme": {
+				Description: "Changing the workflow scheme of a project with issues migrates the issues, which may take a while.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+			},
 		},
 	}
 }
 
+const projectValidateAPIEndpoint = "/rest/api/2/projectvalidate"
+const applicationPropertiesAPIEndpoint = "/rest/api/2/application-properties"
+
+// defaultProjectKeyPattern is checked if the instance does not reveal its
+// key format rules. It accepts every key any instance allows by default and
+// leaves the rest to JIRA.
+const defaultProjectKeyPattern = "([A-Z][A-Z0-9_]+)"
+
+// projectNameMaxLength is the maximum length of project names
+const projectNameMaxLength = 80
+
+// ApplicationProperty is a setting of the JIRA instance
+type ApplicationProperty struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// ValidationErrors is returned by JIRA when validating project keys
+type ValidationErrors struct {
+	ErrorMessages []string          `json:"errorMessages"`
+	Errors        map[string]string `json:"errors"`
+}
+
+// resourceProjectCustomizeDiff validates new project keys and names during plan
+func resourceProjectCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	config := m.(*Config)
+	problems := []string{}
+
+	if d.NewValueKnown("key") && (d.Id() == "" || d.HasChange("key")) {
+		keyProblems, err := validateProjectKey(ctx, config, d.Get("key").(string), !d.Get("restore").(bool))
+		if err != nil {
+			return err
+		}
+		problems = append(problems, keyProblems...)
+	}
+
+	// A restored project keeps its name, which is in use by the project itself
+	if d.NewValueKnown("name") && (d.Id() == "" || d.HasChange("name")) && !d.Get("restore").(bool) {
+		nameProblems, err := validateProjectName(ctx, config, d.Get("name").(string))
+		if err != nil {
+			return err
+		}
+		problems = append(problems, nameProblems...)
+	}
+
+	if len(problems) == 0 {
+		return nil
+	}
+
+	return fmt.Errorf("invalid project\n%s", strings.Join(problems, "\n"))
+}
+
+// resourceProjectValidateStyle rejects options which team-managed projects do not support
+func resourceProjectValidateStyle(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if !d.NewValueKnown("project_style") || d.Get("project_style").(string) != "team_managed" {
+		return nil
+	}
+
+	problems := []string{}
+
+	if config := d.GetRawConfig(); !config.IsNull() {
+		for _, attribute := range classicProjectAttributes {
+			if !config.GetAttr(attribute).IsNull() {
+				problems = append(problems, fmt.Sprintf("%s: only classic projects use shared schemes, configure team-managed projects in the project settings", attribute))
+			}
+		}
+	}
+
+	if d.Id() == "" && d.NewValueKnown("project_template_key") && d.NewValueKnown("project_type_key") && d.Get("project_template_key").(string) == "" {
+		projectTypeKey := d.Get("project_type_key").(string)
+		if _, ok := teamManagedProjectTemplates[projectTypeKey]; !ok {
+			problems = append(problems, fmt.Sprintf("project_template_key: required for team-managed projects of type %q", projectTypeKey))
+		}
+	}
+
+	if len(problems) == 0 {
+		return nil
+	}
+
+	return fmt.Errorf("invalid team-managed project\n%s", strings.Join(problems, "\n"))
+}
+
+// projectKeyRules returns the pattern and maximum length of project keys
+// configured for the instance. Reading them requires administrator
+// permissions, otherwise a permissive pattern and no maximum length are used.
+func projectKeyRules(ctx context.Context, config *Config) (string, int) {
+	pattern := defaultProjectKeyPattern
+	maxLength := 0
+
+	property := new(ApplicationProperty)
+	_, err := requestWithContext(ctx, config.jiraClient, "GET", fmt.Sprintf("%s?key=jira.projectkey.pattern", applicationPropertiesAPIEndpoint), nil, property)
+	if err == nil && property.Value != "" {
+		pattern = property.Value
+	}
+
+	property = new(ApplicationProperty)
+	_, err = requestWithContext(ctx, config.jiraClient, "GET", fmt.Sprintf("%s?key=jira.projectkey.maxlength", applicationPropertiesAPIEndpoint), nil, property)
+	if err == nil {
+		if value, err := strconv.Atoi(property.Value); err == nil {
+			maxLength = value
+		}
+	}
+
+	return pattern, maxLength
+}
+
+// projectKeyFormatProblems checks a key against the key format rules. A
+// maxLength of 0 means the maximum length is unknown.
+func projectKeyFormatProblems(key string, pattern string, maxLength int) []string {
+	problems := []string{}
+
+	if maxLength > 0 && len(key) > maxLength {
+		problems = append(problems, fmt.Sprintf("key: %s is longer than %d characters", key, maxLength))
+	}
+
+	if expression, err := regexp.Compile(fmt.Sprintf("^(?:%s)$", pattern)); err == nil && !expression.MatchString(key) {
+		problems = append(problems, fmt.Sprintf("key: %s does not match the project key pattern %s", key, pattern))
+	}
+
+	return problems
+}
+
+// validateProjectKey returns the reasons a key can't be used for a new
+// project. The key is checked against existing projects if checkExisting is set.
+func validateProjectKey(ctx context.Context, config *Config, key string, checkExisting bool) ([]string, error) {
+	pattern, maxLength := projectKeyRules(ctx, config)
+	if problems := projectKeyFormatProblems(key, pattern, maxLength); len(problems) > 0 || !checkExisting {
+		return problems, nil
+	}
+
+	validation := new(ValidationErrors)
+	res, err := requestWithContext(ctx, config.jiraClient, "GET", fmt.Sprintf("%s/key?key=%s", projectValidateAPIEndpoint, url.QueryEscape(key)), nil, validation)
+	if err != nil {
+		if isNotFound(res) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, "validating project key failed")
+	}
+
+	problems := []string{}
+	for _, message := range validation.ErrorMessages {
+		problems = append(problems, fmt.Sprintf("key: %s", message))
+	}
+	for _, message := range validation.Errors {
+		problems = append(problems, fmt.Sprintf("key: %s", message))
+	}
+
+	if len(problems) > 0 {
+		var suggestion string
+		_, err := requestWithContext(ctx, config.jiraClient, "GET", fmt.Sprintf("%s/validProjectKey?key=%s", projectValidateAPIEndpoint, url.QueryEscape(key)), nil, &suggestion)
+		if err == nil && suggestion != "" && suggestion != key {
+			problems = append(problems, fmt.Sprintf("key: %s is available instead", suggestion))
+		}
+	}
+
+	return problems, nil
+}
+
+// validateProjectName returns the reasons a name can't be used for a project
+func validateProjectName(ctx context.Context, config *Config, name string) ([]string, error) {
+	if len(name) > projectNameMaxLength {
+		return []string{fmt.Sprintf("name: %q is longer than %d characters", name, projectNameMaxLength)}, nil
+	}
+
+	// JIRA returns the name if it is available and a similar one otherwise
+	var suggestion string
+	res, err := requestWithContext(ctx, config.jiraClient, "GET", fmt.Sprintf("%s/validProjectName?name=%s", projectValidateAPIEndpoint, url.QueryEscape(name)), nil, &suggestion)
+	if err != nil {
+		if isNotFound(res) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, "validating project name failed")
+	}
+
+	if suggestion != "" && suggestion != name {
+		return []string{fmt.Sprintf("name: a project named %q already exists, %q is available instead", name, suggestion)}, nil
+	}
+
+	return nil, nil
+}
+
 // resourceProjectCreate creates a new jira issue using the jira api
-func resourceProjectCreate(d *schema.ResourceData, m interface{}) error {
+func resourceProjectCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	config := m.(*Config)
 
+	if d.Get("restore").(bool) {
+		projectID, err := restoreProject(ctx, config, d.Get("key").(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if projectID != "" {
+			d.SetId(projectID)
+			return resourceProjectUpdate(ctx, d, m)
+		}
+	}
+
 	sharedProjectID, useSharedConfiguration := d.GetOk("shared_configuration_project_id")
-	if useSharedConfiguration {
+	if d.Get("project_style").(string) == "team_managed" {
+		cloud, err := isCloud(config.jiraClient)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if !cloud {
+			return diag.Errorf("team-managed projects can only be created on JIRA Cloud")
+		}
+
+		projectTypeKey := d.Get("project_type_key").(string)
+		templateKey := d.Get("project_template_key").(string)
+		if templateKey == "" {
+			templateKey = teamManagedProjectTemplates[projectTypeKey]
+		}
+
+		project := &ProjectRequest{
+			Key:                d.Get("key").(string),
+			Name:               d.Get("name").(string),
+			ProjectTypeKey:     projectTypeKey,
+			ProjectTemplateKey: templateKey,
+			Description:        d.Get("description").(string),
+			LeadAccountID:      d.Get("lead_account_id").(string),
+			URL:                d.Get("url").(string),
+			AssigneeType:       d.Get("assignee_type").(string),
+			AvatarID:           d.Get("avatar_id").(int),
+			CategoryID:         d.Get("category_id").(int),
+		}
+
+		returnedProject := new(IDResponse)
+		_, err = requestWithContext(ctx, config.jiraClient, "POST", teamManagedProjectAPIEndpoint, project, returnedProject)
+		if err != nil {
+			return diag.FromErr(errors.Wrap(err, "creating team-managed jira project failed"))
+		}
+
+		d.SetId(strconv.Itoa(returnedProject.ID))
+
+	} else if useSharedConfiguration {
 		project := &ProjectRequest{
 			Key:           d.Get("key").(string),
 			Name:          d.Get("name").(string),
@@ -155,16 +526,12 @@ func resourceProjectCreate(d *schema.ResourceData, m interface{}) error {
 		err := request(config.jiraClient, "POST", endpoint, project, returnedProject)
 
 		if err != nil {
-			return errors.Wrap(err, "Request failed")
+			return diag.FromErr(errors.Wrap(err, "Request failed"))
 		}
 
 		d.SetId(strconv.Itoa(returnedProject.ProjectID))
 
-		err = resourceProjectUpdate(d, m)
-
-		if err != nil {
-			return errors.Wrap(err, "Request failed")
-		}
+		return resourceProjectUpdate(ctx, d, m)
 
 	} else {
 		project := &ProjectRequest{
@@ -188,23 +555,31 @@ func resourceProjectCreate(d *schema.ResourceData, m interface{}) error {
 
 		err := request(config.jiraClient, "POST", projectAPIEndpoint, project, returnedProject)
 		if err != nil {
-			return errors.Wrap(err, "Request failed")
+			return diag.FromErr(errors.Wrap(err, "Request failed"))
 		}
 
 		d.SetId(strconv.Itoa(returnedProject.ID))
+
+		if err := updateProjectSchemes(ctx, config, d); err != nil {
+			return taskDiagnostics(err)
+		}
 	}
 
-	return resourceProjectRead(d, m)
+	return resourceProjectRead(ctx, d, m)
 
 }
 
 // resourceProjectRead reads issue details using jira api
-func resourceProjectRead(d *schema.ResourceData, m interface{}) error {
+func resourceProjectRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	config := m.(*Config)
 
-	project, _, err := config.jiraClient.Project.Get(d.Id())
+	project, res, err := getProject(ctx, config, d.Id())
 	if err != nil {
-		return errors.Wrap(err, "getting jira project failed")
+		if isNotFound(res) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
 	}
 
 	id, _ := strconv.Atoi(d.Id())
@@ -217,30 +592,41 @@ func resourceProjectRead(d *schema.ResourceData, m interface{}) error {
 	d.Set("url", project.URL)
 	d.Set("assignee_type", project.AssigneeType)
 	d.Set("category_id", project.ProjectCategory.ID)
+	d.Set("project_style", projectStyle(project))
 
 	issuesecuritylevelscheme, err := GetJiraResourceID(config.jiraClient, fmt.Sprintf("%s/%s/issuesecuritylevelscheme", projectAPIEndpoint, d.Id()))
 	if err != nil {
-		return errors.Wrap(err, "getting issuesecuritylevelscheme failed")
+		return diag.FromErr(errors.Wrap(err, "getting issuesecuritylevelscheme failed"))
 	}
 	d.Set("issue_security_scheme", issuesecuritylevelscheme)
 
 	notificationscheme, err := GetJiraResourceID(config.jiraClient, fmt.Sprintf("%s/%s/notificationscheme", projectAPIEndpoint, d.Id()))
 	if err != nil {
-		return errors.Wrap(err, "getting notificationscheme failed")
+		return diag.FromErr(errors.Wrap(err, "getting notificationscheme failed"))
 	}
 	d.Set("notification_scheme", notificationscheme)
 
 	permissionscheme, err := GetJiraResourceID(config.jiraClient, fmt.Sprintf("%s/%s/permissionscheme", projectAPIEndpoint, d.Id()))
 	if err != nil {
-		return errors.Wrap(err, "getting permissionscheme failed")
+		return diag.FromErr(errors.Wrap(err, "getting permissionscheme failed"))
 	}
 	d.Set("permission_scheme", permissionscheme)
 
+	for _, association := range projectSchemeAssociations {
+		schemeID, err := getProjectSchemeAssociation(ctx, config, association, d.Id())
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if schemeID != nil {
+			d.Set(association.Attribute, *schemeID)
+		}
+	}
+
 	return nil
 }
 
 // resourceProjectUpdate updates jira issue using jira api
-func resourceProjectUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceProjectUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	config := m.(*Config)
 
 	project := &ProjectRequest{
@@ -265,22 +651,238 @@ func resourceProjectUpdate(d *schema.ResourceData, m interface{}) error {
 
 	err := request(config.jiraClient, "PUT", urlStr, project, returnedProject)
 	if err != nil {
-		return errors.Wrap(err, "Request failed")
+		return diag.FromErr(errors.Wrap(err, "Request failed"))
+	}
+
+	if err := updateProjectSchemes(ctx, config, d); err != nil {
+		return taskDiagnostics(err)
 	}
 
-	return resourceProjectRead(d, m)
+	return resourceProjectRead(ctx, d, m)
 }
 
-// resourceProjectDelete deletes jira issue using the jira api
-func resourceProjectDelete(d *schema.ResourceData, m interface{}) error {
+// resourceProjectDelete deletes, trashes or archives the jira project using the jira api
+func resourceProjectDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	config := m.(*Config)
+	key := d.Get("key").(string)
+
+	if d.Get("deletion_protection").(bool) {
+		issueCount, err := countIssues(ctx, config, fmt.Sprintf("project = %s", d.Id()))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if issueCount > 0 {
+			return diag.Errorf("project %s contains %d issues and deletion_protection is enabled, disable it to destroy the project", key, issueCount)
+		}
+	}
+
+	cloud, err := isCloud(config.jiraClient)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
 	urlStr := fmt.Sprintf("%s/%s", projectAPIEndpoint, d.Id())
 
-	err := request(config.jiraClient, "DELETE", urlStr, nil, nil)
+	switch projectDestroyAction(d.Get("on_destroy").(string), cloud) {
+	case "trash":
+		if !cloud {
+			return diag.Errorf("on_destroy = \"trash\" is only supported on JIRA Cloud, use \"archive\" or \"delete\" for project %s", key)
+		}
+
+		// A plain DELETE moves the project to the trash on JIRA Cloud
+		_, err := requestWithContext(ctx, config.jiraClient, "DELETE", urlStr, nil, nil)
+		if err != nil {
+			return diag.FromErr(errors.Wrap(err, "moving jira project to trash failed"))
+		}
+		return nil
+
+	case "archive":
+		method := "POST"
+		if !cloud {
+			method = "PUT"
+		}
+
+		_, err := requestWithContext(ctx, config.jiraClient, method, fmt.Sprintf("%s/archive", urlStr), nil, nil)
+		if err != nil {
+			return diag.FromErr(errors.Wrap(err, "archiving jira project failed"))
+		}
+		return nil
+	}
+
+	// JIRA Cloud only deletes projects permanently if asked to, which happens asynchronously
+	if cloud {
+		task := new(TaskProgress)
+		res, err := requestWithContext(ctx, config.jiraClient, "POST", fmt.Sprintf("%s/delete", urlStr), nil, task)
+		if err != nil {
+			return diag.FromErr(errors.Wrap(err, "deleting jira project failed"))
+		}
+
+		if err := waitForTask(ctx, jiraTask(config.jiraClient, startedTaskID(res, task))); err != nil {
+			return taskDiagnostics(err)
+		}
+		return nil
+	}
+
+	err = request(config.jiraClient, "DELETE", urlStr, nil, nil)
 	if err != nil {
-		return errors.Wrap(err, "Request failed")
+		return diag.FromErr(errors.Wrap(err, "Request failed"))
 	}
 
 	return nil
 }
+
+// projectDestroyAction returns what happens to a project on destroy. Without
+// an explicit choice, JIRA Cloud moves projects to the trash and JIRA Data
+// Center deletes them, as a plain DELETE request does.
+func projectDestroyAction(onDestroy string, cloud bool) string {
+	if onDestroy != "" {
+		return onDestroy
+	}
+	if cloud {
+		return "trash"
+	}
+	return "delete"
+}
+
+// restoreProject restores a trashed or archived project and returns its ID.
+// It returns an empty ID if there is no such project.
+func restoreProject(ctx context.Context, config *Config, key string) (string, error) {
+	cloud, err := isCloud(config.jiraClient)
+	if err != nil {
+		return "", err
+	}
+
+	var projects []ProjectResponse
+	method := "PUT"
+
+	if cloud {
+		page := new(ProjectSearchResponse)
+		endpoint := fmt.Sprintf("%s?keys=%s&status=archived&status=deleted", projectSearchAPIEndpoint, url.QueryEscape(key))
+		if _, err := requestWithContext(ctx, config.jiraClient, "GET", endpoint, nil, page); err != nil {
+			return "", errors.Wrap(err, "searching trashed and archived jira projects failed")
+		}
+		projects = page.Values
+		method = "POST"
+	} else {
+		var all []ProjectResponse
+		if _, err := requestWithContext(ctx, config.jiraClient, "GET", fmt.Sprintf("%s?includeArchived=true", projectAPIEndpoint), nil, &all); err != nil {
+			return "", errors.Wrap(err, "listing archived jira projects failed")
+		}
+		for _, project := range all {
+			if project.Archived {
+				projects = append(projects, project)
+			}
+		}
+	}
+
+	for _, project := range projects {
+		if !strings.EqualFold(project.Key, key) {
+			continue
+		}
+
+		log.Printf("[INFO] Restoring jira project %s (%s)", project.Key, project.ID)
+		_, err := requestWithContext(ctx, config.jiraClient, method, fmt.Sprintf("%s/%s/restore", projectAPIEndpoint, project.ID), nil, nil)
+		if err != nil {
+			return "", errors.Wrap(err, "restoring jira project failed")
+		}
+		return project.ID, nil
+	}
+
+	return "", nil
+}
+
+// getProjectSchemeAssociation returns the ID of the scheme assigned to the
+// project, or nil if the instance does not support looking it up. Projects
+// using the default scheme report 0.
+func getProjectSchemeAssociation(ctx context.Context, config *Config, association ProjectSchemeAssociation, projectID string) (*int, error) {
+	result := new(struct {
+		Values []map[string]json.RawMessage `json:"values"`
+	})
+
+	res, err := requestWithContext(ctx, config.jiraClient, "GET", fmt.Sprintf("%s?projectId=%s", association.Endpoint, projectID), nil, result)
+	if err != nil {
+		if isNotFound(res) {
+			return nil, nil
+		}
+		return nil, errors.Wrapf(err, "getting %s failed", association.Attribute)
+	}
+
+	schemeID := 0
+	for _, value := range result.Values {
+		scheme := new(struct {
+			ID string `json:"id"`
+		})
+		if raw, ok := value[association.SchemeKey]; ok && json.Unmarshal(raw, scheme) == nil {
+			schemeID, _ = strconv.Atoi(scheme.ID)
+		}
+	}
+
+	return &schemeID, nil
+}
+
+// updateProjectSchemes assigns the changed schemes to the project
+func updateProjectSchemes(ctx context.Context, config *Config, d *schema.ResourceData) error {
+	for _, association := range projectSchemeAssociations {
+		schemeID, ok := d.GetOk(association.Attribute)
+		if !ok || !d.HasChange(association.Attribute) {
+			continue
+		}
+
+		if association.Attribute == "workflow_scheme" {
+			issueCount, err := countIssues(ctx, config, fmt.Sprintf("project = %s", d.Id()))
+			if err != nil {
+				return err
+			}
+			if issueCount > 0 {
+				if err := switchWorkflowScheme(ctx, config, d.Id(), strconv.Itoa(schemeID.(int))); err != nil {
+					return err
+				}
+				continue
+			}
+		}
+
+		body := map[string]string{
+			"projectId":       d.Id(),
+			association.IDKey: strconv.Itoa(schemeID.(int)),
+		}
+		_, err := requestWithContext(ctx, config.jiraClient, "PUT", association.Endpoint, body, nil)
+		if err != nil {
+			return errors.Wrapf(err, "assigning %s failed", association.Attribute)
+		}
+	}
+
+	return nil
+}
+
+// switchWorkflowScheme migrates the issues of a project to another workflow
+// scheme and waits for the migration to finish
+func switchWorkflowScheme(ctx context.Context, config *Config, projectID string, schemeID string) error {
+	request := WorkflowSchemeSwitchRequest{
+		ProjectID:                   projectID,
+		TargetSchemeID:              schemeID,
+		MappingsByIssueTypeOverride: []interface{}{},
+	}
+
+	task := new(TaskProgress)
+	res, err := requestWithContext(ctx, config.jiraClient, "POST", workflowSchemeSwitchAPIEndpoint, request, task)
+	if err != nil {
+		return errors.Wrap(err, "switching workflow scheme failed")
+	}
+
+	return waitForTask(ctx, jiraTask(config.jiraClient, startedTaskID(res, task)))
+}
+
+// resourceProjectImport imports a project by its ID or key
+func resourceProjectImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	project, _, err := getProject(ctx, m.(*Config), d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	d.SetId(project.ID)
+	d.Set("project_type_key", project.ProjectTypeKey)
+	d.Set("deletion_protection", false)
+	d.Set("restore", false)
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,173 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/pkg/errors"
+)
+
+// ComponentRequest is sent to JIRA to create or update a component
+type ComponentRequest struct {
+	Project       string `json:"project,omitempty"`
+	Name          string `json:"name"`
+	Description   string `json:"description"`
+	LeadAccountID string `json:"leadAccountId,omitempty"`
+	AssigneeType  string `json:"assigneeType"`
+}
+
+// ComponentUpdateRequest is sent to JIRA to update a component. An empty
+// lead is sent as well, which removes the lead of the component.
+type ComponentUpdateRequest struct {
+	ComponentRequest
+	LeadAccountID string `json:"leadAccountId"`
+}
+
+// Component is returned by JIRA for a single component
+type Component struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Lead        struct {
+		AccountID string `json:"accountId"`
+	} `json:"lead"`
+	AssigneeType string `json:"assigneeType"`
+	Project      string `json:"project"`
+	ProjectID    int    `json:"projectId"`
+}
+
+// resourceProjectComponent is used to define a component of a JIRA project
+func resourceProjectComponent() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceProjectComponentCreate,
+		ReadContext:   resourceProjectComponentRead,
+		UpdateContext: resourceProjectComponentUpdate,
+		DeleteContext: resourceProjectComponentDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"project_key": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"lead_account_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"assignee_type": {
+				Description:  "Who new issues of the component are assigned to.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "PROJECT_DEFAULT",
+				ValidateFunc: validation.StringInSlice([]string{"PROJECT_DEFAULT", "COMPONENT_LEAD", "PROJECT_LEAD", "UNASSIGNED"}, false),
+			},
+			"move_issues_to": {
+				Description: "ID of the component the issues are moved to when this component is deleted.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			// Computed values
+			"project_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func componentRequest(d *schema.ResourceData) ComponentRequest {
+	return ComponentRequest{
+		Project:       d.Get("project_key").(string),
+		Name:          d.Get("name").(string),
+		Description:   d.Get("description").(string),
+		LeadAccountID: d.Get("lead_account_id").(string),
+		AssigneeType:  d.Get("assignee_type").(string),
+	}
+}
+
+// resourceProjectComponentCreate creates a new jira component using the jira api
+func resourceProjectComponentCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*Config)
+
+	component := new(Component)
+	_, err := requestWithContext(ctx, config.jiraClient, "POST", componentAPIEndpoint, componentRequest(d), component)
+	if err != nil {
+		return diag.FromErr(errors.Wrap(err, "creating jira component failed"))
+	}
+
+	d.SetId(component.ID)
+
+	return resourceProjectComponentRead(ctx, d, m)
+}
+
+// resourceProjectComponentRead reads component details using jira api
+func resourceProjectComponentRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*Config)
+
+	component := new(Component)
+	res, err := requestWithContext(ctx, config.jiraClient, "GET", fmt.Sprintf("%s/%s", componentAPIEndpoint, d.Id()), nil, component)
+	if err != nil {
+		if isNotFound(res) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(errors.Wrap(err, "getting jira component failed"))
+	}
+
+	d.Set("project_key", component.Project)
+	d.Set("project_id", component.ProjectID)
+	d.Set("name", component.Name)
+	d.Set("description", component.Description)
+	d.Set("lead_account_id", component.Lead.AccountID)
+	d.Set("assignee_type", component.AssigneeType)
+
+	return nil
+}
+
+// resourceProjectComponentUpdate updates jira component using jira api
+func resourceProjectComponentUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*Config)
+
+	request := componentRequest(d)
+	request.Project = ""
+	update := ComponentUpdateRequest{ComponentRequest: request, LeadAccountID: request.LeadAccountID}
+
+	_, err := requestWithContext(ctx, config.jiraClient, "PUT", fmt.Sprintf("%s/%s", componentAPIEndpoint, d.Id()), update, nil)
+	if err != nil {
+		return diag.FromErr(errors.Wrap(err, "updating jira component failed"))
+	}
+
+	return resourceProjectComponentRead(ctx, d, m)
+}
+
+// resourceProjectComponentDelete deletes jira component using the jira api
+func resourceProjectComponentDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*Config)
+
+	endpoint := fmt.Sprintf("%s/%s", componentAPIEndpoint, d.Id())
+	if moveIssuesTo, ok := d.GetOk("move_issues_to"); ok {
+		endpoint = fmt.Sprintf("%s?moveIssuesTo=%s", endpoint, url.QueryEscape(moveIssuesTo.(string)))
+	}
+
+	res, err := requestWithContext(ctx, config.jiraClient, "DELETE", endpoint, nil, nil)
+	if err != nil && !isNotFound(res) {
+		return diag.FromErr(errors.Wrap(err, "deleting jira component failed"))
+	}
+
+	return nil
+}
@@ -0,0 +1,120 @@
+package jira
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccJiraProjectComponent_basic(t *testing.T) {
+	rInt := acctest.RandInt()
+	resourceName := "jira_project_component.backend"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckJiraProjectComponentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJiraProjectComponentConfig(rInt, "Backend"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJiraProjectComponentExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", "Backend"),
+				),
+			},
+			{
+				Config: testAccJiraProjectComponentConfig(rInt, "Services"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJiraProjectComponentExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", "Services"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestComponentUpdateRequest(t *testing.T) {
+	request := ComponentRequest{Name: "Backend", AssigneeType: "PROJECT_DEFAULT"}
+
+	encoded, err := json.Marshal(ComponentUpdateRequest{ComponentRequest: request, LeadAccountID: request.LeadAccountID})
+	expected := `{"name":"Backend","description":"","assigneeType":"PROJECT_DEFAULT","leadAccountId":""}`
+	if err != nil || string(encoded) != expected {
+		t.Errorf("encoded update request = %s, %v, want %s", encoded, err, expected)
+	}
+}
+
+func testAccCheckJiraProjectComponentDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*Config).jiraClient
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "jira_project_component" {
+			continue
+		}
+
+		req, _ := client.NewRequest("GET", fmt.Sprintf("%s/%s", componentAPIEndpoint, rs.Primary.ID), nil)
+		resp, _ := client.Do(req, nil)
+
+		if resp.StatusCode != 404 {
+			return fmt.Errorf("Component %q still exists", rs.Primary.ID)
+		}
+		return nil
+	}
+	return nil
+}
+
+func testAccCheckJiraProjectComponentExists(n string) resource.TestCheckFunc {
+
+	return func(s *terraform.State) error {
+
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not Found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No component ID is set")
+		}
+
+		client := testAccProvider.Meta().(*Config).jiraClient
+		req, _ := client.NewRequest("GET", fmt.Sprintf("%s/%s", componentAPIEndpoint, rs.Primary.ID), nil)
+		resp, _ := client.Do(req, nil)
+
+		if resp.StatusCode != 200 {
+			return fmt.Errorf("Component %q does not exists", rs.Primary.ID)
+		}
+		return nil
+	}
+
+}
+
+func testAccJiraProjectComponentConfig(rInt int, name string) string {
+	return fmt.Sprintf(`
+resource "jira_user" "foo" {
+	name = "project-user-%d"
+	email = "example@example.org"
+}
+
+resource "jira_project" "foo" {
+  name = "foo-name-%d"
+  key = "PX%d"
+  lead = "${jira_user.foo.name}"
+  project_type_key = "business"
+  project_template_key = "com.atlassian.jira-core-project-templates:jira-core-project-management"
+}
+
+resource "jira_project_component" "backend" {
+	project_key = "${jira_project.foo.key}"
+	name        = "%s"
+	description = "Created using Terraform"
+}
+`, rInt, rInt, rInt%100000, name)
+}
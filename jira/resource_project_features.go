@@ -0,0 +1,296 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/andygrunwald/go-jira"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+)
+
+func projectFeaturesAPIEndpoint(projectKey string) string {
+	return fmt.Sprintf("/rest/api/3/project/%s/features", projectKey)
+}
+
+// ProjectFeature is a feature of a project, like the backlog or sprints
+type ProjectFeature struct {
+	ProjectID            int64    `json:"projectId"`
+	Feature              string   `json:"feature"`
+	State                string   `json:"state"`
+	ToggleLocked         bool     `json:"toggleLocked"`
+	Prerequisites        []string `json:"prerequisites"`
+	LocalisedName        string   `json:"localisedName"`
+	LocalisedDescription string   `json:"localisedDescription"`
+}
+
+// ProjectFeaturesResponse is returned by JIRA when listing the features of a project
+type ProjectFeaturesResponse struct {
+	Features []ProjectFeature `json:"features"`
+}
+
+// ProjectFeatureRequest is sent to JIRA to enable or disable a feature
+type ProjectFeatureRequest struct {
+	State string `json:"state"`
+}
+
+// ProjectFeatureToggle is a change of the state of a feature
+type ProjectFeatureToggle struct {
+	Feature string
+	State   string
+}
+
+// resourceProjectFeatures is used to define which features of a project are enabled
+func resourceProjectFeatures() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceProjectFeaturesCreate,
+		ReadContext:   resourceProjectFeaturesRead,
+		UpdateContext: resourceProjectFeaturesUpdate,
+		DeleteContext: resourceProjectFeaturesDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		CustomizeDiff: resourceProjectFeaturesCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"project_key": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"enabled_features": {
+				Description: "Keys of the features to enable, e.g. jsw.agility.sprints. All other features which are not locked are disabled.",
+				Type:        schema.TypeSet,
+				Required:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+// getProjectFeatures fetches the features of a project
+func getProjectFeatures(ctx context.Context, config *Config, projectKey string) ([]ProjectFeature, *jira.Response, error) {
+	result := new(ProjectFeaturesResponse)
+	res, err := requestWithContext(ctx, config.jiraClient, "GET", projectFeaturesAPIEndpoint(projectKey), nil, result)
+	if err != nil {
+		return nil, res, errors.Wrap(err, "getting jira project features failed")
+	}
+	return result.Features, res, nil
+}
+
+// projectFeatureToggles returns the changes needed to enable exactly the given
+// features. Prerequisites are enabled before and disabled after the features
+// requiring them. Locked features can't be changed.
+func projectFeatureToggles(features []ProjectFeature, enabled map[string]bool) ([]ProjectFeatureToggle, error) {
+	byKey := map[string]ProjectFeature{}
+	for _, feature := range features {
+		byKey[feature.Feature] = feature
+	}
+
+	problems := []string{}
+	toEnable := map[string]bool{}
+	toDisable := map[string]bool{}
+
+	for key := range enabled {
+		feature, ok := byKey[key]
+		if !ok {
+			problems = append(problems, fmt.Sprintf("%s: feature is not available", key))
+			continue
+		}
+
+		if feature.ToggleLocked && feature.State != "ENABLED" {
+			problems = append(problems, fmt.Sprintf("%s: feature is locked and can't be enabled", key))
+		} else if feature.State != "ENABLED" {
+			toEnable[key] = true
+		}
+
+		// Prerequisites stay enabled if they are configured or locked
+		for _, prerequisite := range feature.Prerequisites {
+			required, ok := byKey[prerequisite]
+			if !enabled[prerequisite] && !(ok && required.ToggleLocked && required.State == "ENABLED") {
+				problems = append(problems, fmt.Sprintf("%s: feature requires %s", key, prerequisite))
+			}
+		}
+	}
+
+	for _, feature := range features {
+		if !feature.ToggleLocked && feature.State == "ENABLED" && !enabled[feature.Feature] {
+			toDisable[feature.Feature] = true
+		}
+	}
+
+	if len(problems) > 0 {
+		sort.Strings(problems)
+		return nil, fmt.Errorf("invalid project features\n%s", strings.Join(problems, "\n"))
+	}
+
+	toggles := []ProjectFeatureToggle{}
+	for _, key := range orderByPrerequisites(toEnable, byKey) {
+		toggles = append(toggles, ProjectFeatureToggle{Feature: key, State: "ENABLED"})
+	}
+
+	disable := orderByPrerequisites(toDisable, byKey)
+	for i := len(disable) - 1; i >= 0; i-- {
+		toggles = append(toggles, ProjectFeatureToggle{Feature: disable[i], State: "DISABLED"})
+	}
+
+	return toggles, nil
+}
+
+// orderByPrerequisites sorts the keys so every feature follows its prerequisites
+func orderByPrerequisites(keys map[string]bool, byKey map[string]ProjectFeature) []string {
+	sorted := []string{}
+	for key := range keys {
+		sorted = append(sorted, key)
+	}
+	sort.Strings(sorted)
+
+	ordered := []string{}
+	visited := map[string]bool{}
+
+	var visit func(key string)
+	visit = func(key string) {
+		if visited[key] {
+			return
+		}
+		visited[key] = true
+		for _, prerequisite := range byKey[key].Prerequisites {
+			if keys[prerequisite] {
+				visit(prerequisite)
+			}
+		}
+		ordered = append(ordered, key)
+	}
+
+	for _, key := range sorted {
+		visit(key)
+	}
+
+	return ordered
+}
+
+// resourceProjectFeaturesCustomizeDiff checks during plan that the configured
+// features are available and their prerequisites are enabled
+func resourceProjectFeaturesCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if !d.NewValueKnown("project_key") || !d.NewValueKnown("enabled_features") {
+		return nil
+	}
+	if d.Id() != "" && !d.HasChange("enabled_features") {
+		return nil
+	}
+
+	projectKey := d.Get("project_key").(string)
+
+	features, res, err := getProjectFeatures(ctx, m.(*Config), projectKey)
+	if err != nil {
+		// The project may be created in the same apply
+		if isNotFound(res) {
+			log.Printf("[DEBUG] Skipping validation of features, as project %s does not exist yet", projectKey)
+			return nil
+		}
+		return err
+	}
+
+	_, err = projectFeatureToggles(features, projectFeaturesEnabled(d.Get("enabled_features").(*schema.Set)))
+	return err
+}
+
+// projectFeaturesEnabled returns the configured features as a lookup table
+func projectFeaturesEnabled(set *schema.Set) map[string]bool {
+	enabled := map[string]bool{}
+	for _, key := range stringList(set.List()) {
+		enabled[key] = true
+	}
+	return enabled
+}
+
+// applyProjectFeatures enables exactly the configured features of the project
+func applyProjectFeatures(ctx context.Context, config *Config, d *schema.ResourceData) error {
+	projectKey := d.Get("project_key").(string)
+
+	features, _, err := getProjectFeatures(ctx, config, projectKey)
+	if err != nil {
+		return err
+	}
+
+	toggles, err := projectFeatureToggles(features, projectFeaturesEnabled(d.Get("enabled_features").(*schema.Set)))
+	if err != nil {
+		return err
+	}
+
+	for _, toggle := range toggles {
+		log.Printf("[INFO] Setting feature %s of jira project %s to %s", toggle.Feature, projectKey, toggle.State)
+
+		urlStr := fmt.Sprintf("%s/%s", projectFeaturesAPIEndpoint(projectKey), toggle.Feature)
+		_, err := requestWithContext(ctx, config.jiraClient, "PUT", urlStr, &ProjectFeatureRequest{State: toggle.State}, nil)
+		if err != nil {
+			return errors.Wrapf(err, "setting feature %s of jira project failed", toggle.Feature)
+		}
+	}
+
+	return nil
+}
+
+// resourceProjectFeaturesCreate sets the features of a jira project using the jira api
+func resourceProjectFeaturesCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*Config)
+
+	if err := applyProjectFeatures(ctx, config, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(d.Get("project_key").(string))
+
+	return resourceProjectFeaturesRead(ctx, d, m)
+}
+
+// resourceProjectFeaturesRead reads the enabled features of a jira project using the jira api
+func resourceProjectFeaturesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*Config)
+
+	features, res, err := getProjectFeatures(ctx, config, d.Id())
+	if err != nil {
+		if isNotFound(res) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	// Locked features are only tracked if they are configured
+	configured := d.Get("enabled_features").(*schema.Set)
+
+	enabled := []string{}
+	for _, feature := range features {
+		if feature.State == "ENABLED" && (!feature.ToggleLocked || configured.Contains(feature.Feature)) {
+			enabled = append(enabled, feature.Feature)
+		}
+	}
+
+	d.Set("project_key", d.Id())
+	d.Set("enabled_features", enabled)
+
+	return nil
+}
+
+// resourceProjectFeaturesUpdate sets the features of a jira project using the jira api
+func resourceProjectFeaturesUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*Config)
+
+	if err := applyProjectFeatures(ctx, config, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceProjectFeaturesRead(ctx, d, m)
+}
+
+// resourceProjectFeaturesDelete leaves the features of the project as they are,
+// as there is no state to return to
+func resourceProjectFeaturesDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] Features of jira project %s are left as they are", d.Id())
+	return nil
+}
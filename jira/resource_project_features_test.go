@@ -0,0 +1,61 @@
+package jira
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestProjectFeatureToggles(t *testing.T) {
+	features := []ProjectFeature{
+		{Feature: "jsw.agility.sprints", State: "DISABLED", Prerequisites: []string{"jsw.agility.backlog"}},
+		{Feature: "jsw.agility.backlog", State: "DISABLED"},
+		{Feature: "jsw.agility.releases", State: "ENABLED"},
+		{Feature: "jsw.agility.reports", State: "ENABLED", Prerequisites: []string{"jsw.agility.releases"}},
+		{Feature: "jsw.agility.board", State: "ENABLED", ToggleLocked: true},
+		{Feature: "jsw.agility.code", State: "DISABLED", ToggleLocked: true},
+	}
+
+	toggles, err := projectFeatureToggles(features, map[string]bool{
+		"jsw.agility.sprints": true,
+		"jsw.agility.backlog": true,
+	})
+	if err != nil {
+		t.Fatalf("projectFeatureToggles failed: %s", err)
+	}
+
+	expected := []ProjectFeatureToggle{
+		{"jsw.agility.backlog", "ENABLED"},
+		{"jsw.agility.sprints", "ENABLED"},
+		{"jsw.agility.reports", "DISABLED"},
+		{"jsw.agility.releases", "DISABLED"},
+	}
+	if !reflect.DeepEqual(toggles, expected) {
+		t.Errorf("projectFeatureToggles = %v, want %v", toggles, expected)
+	}
+
+	_, err = projectFeatureToggles(features, map[string]bool{
+		"jsw.agility.code":    true,
+		"jsw.agility.unknown": true,
+	})
+	if err == nil || err.Error() != "invalid project features\njsw.agility.code: feature is locked and can't be enabled\njsw.agility.unknown: feature is not available" {
+		t.Errorf("projectFeatureToggles with unavailable features returned %v", err)
+	}
+
+	_, err = projectFeatureToggles(features, map[string]bool{
+		"jsw.agility.sprints": true,
+		"jsw.agility.reports": true,
+	})
+	if err == nil || err.Error() != "invalid project features\njsw.agility.reports: feature requires jsw.agility.releases\njsw.agility.sprints: feature requires jsw.agility.backlog" {
+		t.Errorf("projectFeatureToggles without prerequisites returned %v", err)
+	}
+
+	// Locked prerequisites which are enabled don't have to be configured
+	locked := append(features, ProjectFeature{Feature: "jsw.agility.timeline", State: "DISABLED", Prerequisites: []string{"jsw.agility.board"}})
+	toggles, err = projectFeatureToggles(locked, map[string]bool{"jsw.agility.timeline": true})
+	if err != nil {
+		t.Fatalf("projectFeatureToggles with a locked prerequisite failed: %s", err)
+	}
+	if len(toggles) == 0 || toggles[0] != (ProjectFeatureToggle{"jsw.agility.timeline", "ENABLED"}) {
+		t.Errorf("projectFeatureToggles with a locked prerequisite = %v", toggles)
+	}
+}
@@ -23,6 +23,12 @@ func TestAccJiraProject_basic(t *testing.T) {
 					testAccCheckJiraProjectExists("jira_project.foo"),
 				),
 			},
+			{
+				ResourceName:            "jira_project.foo",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"lead", "project_template_key"},
+			},
 		},
 	})
 }
@@ -128,3 +134,50 @@ resource "jira_project" "foo_shared" {
 	shared_configuration_project_id = "${jira_project.foo.project_id}"
 }`, rInt, rInt, rInt%100000, rInt, rInt%100000)
 }
+
+func TestProjectKeyFormatProblems(t *testing.T) {
+	cases := []struct {
+		key       string
+		pattern   string
+		maxLength int
+		problems  int
+	}{
+		{"PROJ", defaultProjectKeyPattern, 0, 0},
+		{"proj", defaultProjectKeyPattern, 0, 1},
+		{"P", defaultProjectKeyPattern, 0, 1},
+		{"PROJ1", defaultProjectKeyPattern, 0, 0},
+		{"PX12345", defaultProjectKeyPattern, 0, 0},
+		{"PRO_J", defaultProjectKeyPattern, 0, 0},
+		{"1PROJ", defaultProjectKeyPattern, 0, 1},
+		{"PROJ1", "([A-Z][A-Z]+)", 10, 1},
+		{"VERYLONGPROJECT", defaultProjectKeyPattern, 0, 0},
+		{"VERYLONGPROJECT", defaultProjectKeyPattern, 10, 1},
+		{"VERYLONGPROJECT1", "([A-Z][A-Z]+)", 10, 2},
+	}
+
+	for _, c := range cases {
+		if problems := projectKeyFormatProblems(c.key, c.pattern, c.maxLength); len(problems) != c.problems {
+			t.Errorf("projectKeyFormatProblems(%q, %q, %d) = %v", c.key, c.pattern, c.maxLength, problems)
+		}
+	}
+}
+
+func TestProjectDestroyAction(t *testing.T) {
+	cases := []struct {
+		onDestroy string
+		cloud     bool
+		expected  string
+	}{
+		{"", true, "trash"},
+		{"", false, "delete"},
+		{"delete", true, "delete"},
+		{"archive", false, "archive"},
+		{"trash", true, "trash"},
+	}
+
+	for _, c := range cases {
+		if action := projectDestroyAction(c.onDestroy, c.cloud); action != c.expected {
+			t.Errorf("projectDestroyAction(%q, %t) = %q, want %q", c.onDestroy, c.cloud, action, c.expected)
+		}
+	}
+}
@@ -0,0 +1,256 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"path"
+	"regexp"
+	"strconv"
+
+	"github.com/andygrunwald/go-jira"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/pkg/errors"
+)
+
+// VersionRequest is sent to JIRA to create or update a version
+type VersionRequest struct {
+	Project             string `json:"project,omitempty"`
+	Name                string `json:"name"`
+	Description         string `json:"description"`
+	StartDate           string `json:"startDate,omitempty"`
+	ReleaseDate         string `json:"releaseDate,omitempty"`
+	Released            bool   `json:"released"`
+	Archived            bool   `json:"archived"`
+	MoveUnfixedIssuesTo string `json:"moveUnfixedIssuesTo,omitempty"`
+}
+
+// VersionUpdateRequest is sent to JIRA to update a version. Missing dates are
+// sent as null, which removes them from the version.
+type VersionUpdateRequest struct {
+	VersionRequest
+	StartDate   *string `json:"startDate"`
+	ReleaseDate *string `json:"releaseDate"`
+}
+
+// Version is returned by JIRA for a single version
+type Version struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	StartDate   string `json:"startDate"`
+	ReleaseDate string `json:"releaseDate"`
+	Released    bool   `json:"released"`
+	Archived    bool   `json:"archived"`
+	Overdue     bool   `json:"overdue"`
+	ProjectID   int    `json:"projectId"`
+}
+
+var versionDateValidation = validation.StringMatch(regexp.MustCompile(`^\d{4}-\d{2}-\d{2}$`), "must be a date in the format YYYY-MM-DD")
+
+// resourceProjectVersion is used to define a version of a JIRA project
+func resourceProjectVersion() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceProjectVersionCreate,
+		ReadContext:   resourceProjectVersionRead,
+		UpdateContext: resourceProjectVersionUpdate,
+		DeleteContext: resourceProjectVersionDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"project_key": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"start_date": {
+				Description:  "Start of the version in the format YYYY-MM-DD.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: versionDateValidation,
+			},
+			"release_date": {
+				Description:  "Planned or actual release of the version in the format YYYY-MM-DD.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: versionDateValidation,
+			},
+			"released": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"archived": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"move_unfixed_issues_to": {
+				Description: "ID of the version unresolved issues are moved to when this version is released.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"move_fix_issues_to": {
+				Description: "ID of the version issues fixed in this version are moved to when it is deleted.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"move_affected_issues_to": {
+				Description: "ID of the version issues affecting this version are moved to when it is deleted.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			// Computed values
+			"project_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"overdue": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func versionRequest(d *schema.ResourceData) VersionRequest {
+	return VersionRequest{
+		Project:     d.Get("project_key").(string),
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		StartDate:   d.Get("start_date").(string),
+		ReleaseDate: d.Get("release_date").(string),
+		Released:    d.Get("released").(bool),
+		Archived:    d.Get("archived").(bool),
+	}
+}
+
+// versionUpdateRequest wraps request to clear the dates which are not set
+func versionUpdateRequest(request VersionRequest) VersionUpdateRequest {
+	update := VersionUpdateRequest{VersionRequest: request}
+	if request.StartDate != "" {
+		update.StartDate = &request.StartDate
+	}
+	if request.ReleaseDate != "" {
+		update.ReleaseDate = &request.ReleaseDate
+	}
+	return update
+}
+
+// getVersion fetches a version by its ID
+func getVersion(ctx context.Context, config *Config, versionID string) (*Version, *jira.Response, error) {
+	version := new(Version)
+	res, err := requestWithContext(ctx, config.jiraClient, "GET", fmt.Sprintf("%s/%s", versionAPIEndpoint, versionID), nil, version)
+	if err != nil {
+		return nil, res, errors.Wrap(err, "getting jira version failed")
+	}
+	return version, res, nil
+}
+
+// resourceProjectVersionCreate creates a new jira version using the jira api
+func resourceProjectVersionCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*Config)
+
+	version := new(Version)
+	_, err := requestWithContext(ctx, config.jiraClient, "POST", versionAPIEndpoint, versionRequest(d), version)
+	if err != nil {
+		return diag.FromErr(errors.Wrap(err, "creating jira version failed"))
+	}
+
+	d.SetId(version.ID)
+
+	return resourceProjectVersionRead(ctx, d, m)
+}
+
+// resourceProjectVersionRead reads version details using jira api
+func resourceProjectVersionRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*Config)
+
+	version, res, err := getVersion(ctx, config, d.Id())
+	if err != nil {
+		if isNotFound(res) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	// Versions only know the ID of their project, the key is looked up after an import
+	if _, ok := d.GetOk("project_key"); !ok {
+		project, _, err := getProject(ctx, config, strconv.Itoa(version.ProjectID))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		d.Set("project_key", project.Key)
+	}
+
+	d.Set("project_id", version.ProjectID)
+	d.Set("name", version.Name)
+	d.Set("description", version.Description)
+	d.Set("start_date", version.StartDate)
+	d.Set("release_date", version.ReleaseDate)
+	d.Set("released", version.Released)
+	d.Set("archived", version.Archived)
+	d.Set("overdue", version.Overdue)
+
+	return nil
+}
+
+// resourceProjectVersionUpdate updates jira version using jira api
+func resourceProjectVersionUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*Config)
+
+	request := versionRequest(d)
+	request.Project = ""
+
+	// JIRA expects the URL of the version to move the unresolved issues to
+	if moveTo, ok := d.GetOk("move_unfixed_issues_to"); ok && d.HasChange("released") && request.Released {
+		moveToURL := config.jiraClient.GetBaseURL()
+		moveToURL.Path = path.Join(moveToURL.Path, versionAPIEndpoint, moveTo.(string))
+		request.MoveUnfixedIssuesTo = moveToURL.String()
+	}
+
+	_, err := requestWithContext(ctx, config.jiraClient, "PUT", fmt.Sprintf("%s/%s", versionAPIEndpoint, d.Id()), versionUpdateRequest(request), nil)
+	if err != nil {
+		return diag.FromErr(errors.Wrap(err, "updating jira version failed"))
+	}
+
+	return resourceProjectVersionRead(ctx, d, m)
+}
+
+// resourceProjectVersionDelete deletes jira version using the jira api
+func resourceProjectVersionDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*Config)
+
+	query := url.Values{}
+	if moveTo, ok := d.GetOk("move_fix_issues_to"); ok {
+		query.Set("moveFixIssuesTo", moveTo.(string))
+	}
+	if moveTo, ok := d.GetOk("move_affected_issues_to"); ok {
+		query.Set("moveAffectedIssuesTo", moveTo.(string))
+	}
+
+	endpoint := fmt.Sprintf("%s/%s", versionAPIEndpoint, d.Id())
+	if len(query) > 0 {
+		endpoint = fmt.Sprintf("%s?%s", endpoint, query.Encode())
+	}
+
+	res, err := requestWithContext(ctx, config.jiraClient, "DELETE", endpoint, nil, nil)
+	if err != nil && !isNotFound(res) {
+		return diag.FromErr(errors.Wrap(err, "deleting jira version failed"))
+	}
+
+	return nil
+}
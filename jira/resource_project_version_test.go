@@ -0,0 +1,134 @@
+package jira
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestVersionUpdateRequest(t *testing.T) {
+	cases := []struct {
+		startDate   string
+		releaseDate string
+		expected    string
+	}{
+		{"", "", `"startDate":null,"releaseDate":null`},
+		{"2021-01-04", "", `"startDate":"2021-01-04","releaseDate":null`},
+		{"", "2021-03-31", `"startDate":null,"releaseDate":"2021-03-31"`},
+	}
+
+	for _, c := range cases {
+		request := VersionRequest{Name: "1.0", StartDate: c.startDate, ReleaseDate: c.releaseDate}
+		encoded, err := json.Marshal(versionUpdateRequest(request))
+
+		expected := fmt.Sprintf(`{"name":"1.0","description":"","released":false,"archived":false,%s}`, c.expected)
+		if err != nil || string(encoded) != expected {
+			t.Errorf("versionUpdateRequest(%q, %q) encodes to %s, %v, want %s", c.startDate, c.releaseDate, encoded, err, expected)
+		}
+	}
+}
+
+func TestAccJiraProjectVersion_basic(t *testing.T) {
+	rInt := acctest.RandInt()
+	resourceName := "jira_project_version.release"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckJiraProjectVersionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJiraProjectVersionConfig(rInt, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJiraProjectVersionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "released", "false"),
+				),
+			},
+			{
+				Config: testAccJiraProjectVersionConfig(rInt, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJiraProjectVersionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "released", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckJiraProjectVersionDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*Config).jiraClient
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "jira_project_version" {
+			continue
+		}
+
+		req, _ := client.NewRequest("GET", fmt.Sprintf("%s/%s", versionAPIEndpoint, rs.Primary.ID), nil)
+		resp, _ := client.Do(req, nil)
+
+		if resp.StatusCode != 404 {
+			return fmt.Errorf("Version %q still exists", rs.Primary.ID)
+		}
+		return nil
+	}
+	return nil
+}
+
+func testAccCheckJiraProjectVersionExists(n string) resource.TestCheckFunc {
+
+	return func(s *terraform.State) error {
+
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not Found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No version ID is set")
+		}
+
+		client := testAccProvider.Meta().(*Config).jiraClient
+		req, _ := client.NewRequest("GET", fmt.Sprintf("%s/%s", versionAPIEndpoint, rs.Primary.ID), nil)
+		resp, _ := client.Do(req, nil)
+
+		if resp.StatusCode != 200 {
+			return fmt.Errorf("Version %q does not exists", rs.Primary.ID)
+		}
+		return nil
+	}
+
+}
+
+func testAccJiraProjectVersionConfig(rInt int, released bool) string {
+	return fmt.Sprintf(`
+resource "jira_user" "foo" {
+	name = "project-user-%d"
+	email = "example@example.org"
+}
+
+resource "jira_project" "foo" {
+  name = "foo-name-%d"
+  key = "PX%d"
+  lead = "${jira_user.foo.name}"
+  project_type_key = "business"
+  project_template_key = "com.atlassian.jira-core-project-templates:jira-core-project-management"
+}
+
+resource "jira_project_version" "release" {
+	project_key  = "${jira_project.foo.key}"
+	name         = "1.0"
+	description  = "Created using Terraform"
+	release_date = "2021-04-01"
+	released     = %t
+}
+`, rInt, rInt, rInt%100000, released)
+}
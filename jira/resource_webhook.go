@@ -32,6 +32,7 @@ func resourceWebhook() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: jqlCustomizeDiff("jql"),
 
 		Schema: map[string]*schema.Schema{
 			"name": {
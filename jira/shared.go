@@ -1,8 +1,10 @@
 package jira
 
 import (
+	"context"
 	"fmt"
 	"io/ioutil"
+	"net/http"
 	"reflect"
 	"strings"
 
@@ -12,6 +14,7 @@ import (
 )
 
 // API Endpoints
+const componentAPIEndpoint = "/rest/api/2/component"
 const filterAPIEndpoint = "/rest/api/2/filter"
 const groupAPIEndpoint = "/rest/api/3/group"
 const groupUserAPIEndpoint = "/rest/api/3/group/user"
@@ -23,7 +26,9 @@ const issueTypeAPIEndpoint = "/rest/api/2/issuetype"
 const projectAPIEndpoint = "/rest/api/2/project"
 const projectCategoryAPIEndpoint = "/rest/api/2/projectCategory"
 const roleAPIEndpoint = "/rest/api/2/role"
+const serverInfoAPIEndpoint = "/rest/api/2/serverInfo"
 const userAPIEndpoint = "/rest/api/3/user"
+const versionAPIEndpoint = "/rest/api/2/version"
 const webhookAPIEndpoint = "/rest/webhooks/1.0/webhook"
 
 func projectWithSharedConfigurationAPIEndpoint(projectID int) string {
@@ -65,6 +70,56 @@ func request(client *jira.Client, method string, endpoint string, in interface{}
 	return nil
 }
 
+// requestWithContext works like request, but honours ctx and hands back the
+// response, so callers can react to status codes such as 404
+func requestWithContext(ctx context.Context, client *jira.Client, method string, endpoint string, in interface{}, out interface{}) (*jira.Response, error) {
+
+	req, err := client.NewRequestWithContext(ctx, method, endpoint, in)
+
+	if err != nil {
+		return nil, errors.Wrapf(err, "Creating %s Request failed", method)
+	}
+
+	res, err := client.Do(req, out)
+	if err != nil {
+		return res, jira.NewJiraError(res, err)
+	}
+
+	return res, nil
+}
+
+// ServerInfo describes the JIRA instance
+type ServerInfo struct {
+	BaseURL        string `json:"baseUrl"`
+	Version        string `json:"version"`
+	DeploymentType string `json:"deploymentType"`
+}
+
+// isCloud reports whether the client talks to a JIRA Cloud instance
+func isCloud(client *jira.Client) (bool, error) {
+	serverInfo := new(ServerInfo)
+	err := request(client, "GET", serverInfoAPIEndpoint, nil, serverInfo)
+	if err != nil {
+		return false, errors.Wrap(err, "getting server info failed")
+	}
+
+	return serverInfo.DeploymentType == "Cloud", nil
+}
+
+// isNotFound reports whether res carries a 404 status code
+func isNotFound(res *jira.Response) bool {
+	return res != nil && res.StatusCode == http.StatusNotFound
+}
+
+// stringList converts a list attribute to a slice of strings
+func stringList(raw interface{}) []string {
+	values := []string{}
+	for _, value := range raw.([]interface{}) {
+		values = append(values, value.(string))
+	}
+	return values
+}
+
 func caseInsensitiveSuppressFunc(k, old, new string, d *schema.ResourceData) bool {
 	return strings.ToLower(old) == strings.ToLower(new)
 }
@@ -0,0 +1,183 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/andygrunwald/go-jira"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/pkg/errors"
+)
+
+const taskAPIEndpoint = "/rest/api/2/task"
+
+// taskPollInterval is the time between two requests for the state of a task
+const taskPollInterval = 2 * time.Second
+
+// TaskProgress is returned by JIRA for a long running task
+type TaskProgress struct {
+	ID       string          `json:"id"`
+	Status   string          `json:"status"`
+	Message  string          `json:"message"`
+	Progress int             `json:"progress"`
+	Result   json.RawMessage `json:"result"`
+}
+
+// TaskState is the state of a long running operation at one point in time
+type TaskState struct {
+	Status   string
+	Progress int
+	Done     bool
+	Failed   bool
+	Errors   []string
+}
+
+// AsyncTask describes how to follow a long running JIRA operation
+type AsyncTask struct {
+	// Name identifies the task in logs and errors
+	Name string
+	// Poll returns the current state of the task
+	Poll func(ctx context.Context) (*TaskState, error)
+	// Cancel asks JIRA to stop the task. It is nil for tasks which can't be canceled.
+	Cancel func(ctx context.Context) error
+}
+
+// TaskError is returned when a task finished unsuccessfully
+type TaskError struct {
+	Name     string
+	Status   string
+	Messages []string
+}
+
+func (e *TaskError) Error() string {
+	if len(e.Messages) == 0 {
+		return fmt.Sprintf("%s ended with status %s", e.Name, e.Status)
+	}
+	return fmt.Sprintf("%s ended with status %s: %s", e.Name, e.Status, strings.Join(e.Messages, ", "))
+}
+
+// taskDiagnostics turns every message of a failed task into a diagnostic
+func taskDiagnostics(err error) diag.Diagnostics {
+	taskErr, ok := err.(*TaskError)
+	if !ok || len(taskErr.Messages) == 0 {
+		return diag.FromErr(err)
+	}
+
+	var diags diag.Diagnostics
+	for _, message := range taskErr.Messages {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("%s ended with status %s", taskErr.Name, taskErr.Status),
+			Detail:   message,
+		})
+	}
+	return diags
+}
+
+// waitForTask blocks until the task has finished, logging its progress. When
+// ctx is done, e.g. because the resource timed out, the task is canceled.
+func waitForTask(ctx context.Context, task AsyncTask) error {
+	var last *TaskState
+
+	for {
+		state, err := task.Poll(ctx)
+		if err != nil && ctx.Err() == nil {
+			return errors.Wrapf(err, "getting progress of %s failed", task.Name)
+		}
+
+		if state != nil {
+			if last == nil || last.Status != state.Status || last.Progress != state.Progress {
+				log.Printf("[INFO] %s is %s (%d%%)", task.Name, state.Status, state.Progress)
+			}
+			last = state
+
+			if state.Done {
+				if state.Failed {
+					return &TaskError{Name: task.Name, Status: state.Status, Messages: state.Errors}
+				}
+				return nil
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			if task.Cancel != nil {
+				log.Printf("[WARN] Canceling %s", task.Name)
+				if err := task.Cancel(context.Background()); err != nil {
+					log.Printf("[WARN] Canceling %s failed: %s", task.Name, err)
+				}
+			}
+			return errors.Wrapf(ctx.Err(), "waiting for %s failed", task.Name)
+		case <-time.After(taskPollInterval):
+		}
+	}
+}
+
+// jiraTask follows a task of the /task API, as returned by project deletion,
+// archival or workflow scheme migration
+func jiraTask(client *jira.Client, taskID string) AsyncTask {
+	urlStr := fmt.Sprintf("%s/%s", taskAPIEndpoint, taskID)
+
+	return AsyncTask{
+		Name: fmt.Sprintf("task %s", taskID),
+		Poll: func(ctx context.Context) (*TaskState, error) {
+			progress := new(TaskProgress)
+			if _, err := requestWithContext(ctx, client, "GET", urlStr, nil, progress); err != nil {
+				return nil, err
+			}
+
+			state := &TaskState{Status: progress.Status, Progress: progress.Progress}
+			switch progress.Status {
+			case "COMPLETE":
+				state.Done = true
+			case "FAILED", "CANCEL_REQUESTED", "CANCELLED", "DEAD":
+				state.Done = true
+				state.Failed = true
+				state.Errors = taskMessages(progress)
+			}
+			return state, nil
+		},
+		Cancel: func(ctx context.Context) error {
+			_, err := requestWithContext(ctx, client, "POST", fmt.Sprintf("%s/cancel", urlStr), nil, nil)
+			return err
+		},
+	}
+}
+
+// taskMessages returns the error messages of a failed task. JIRA reports
+// them in the result, otherwise the message of the task is used.
+func taskMessages(progress *TaskProgress) []string {
+	result := new(struct {
+		ErrorMessages []string          `json:"errorMessages"`
+		Errors        map[string]string `json:"errors"`
+	})
+
+	messages := []string{}
+	if json.Unmarshal(progress.Result, result) == nil {
+		messages = append(messages, result.ErrorMessages...)
+		for field, message := range result.Errors {
+			messages = append(messages, fmt.Sprintf("%s: %s", field, message))
+		}
+	}
+
+	if len(messages) == 0 && progress.Message != "" {
+		messages = append(messages, progress.Message)
+	}
+
+	return messages
+}
+
+// startedTaskID returns the ID of the task an operation started. JIRA
+// redirects to the task, which the client follows, so the ID is taken from
+// the decoded task or else from the final URL.
+func startedTaskID(res *jira.Response, task *TaskProgress) string {
+	if task.ID != "" || res == nil || res.Request == nil {
+		return task.ID
+	}
+	return path.Base(res.Request.URL.Path)
+}
@@ -0,0 +1,56 @@
+package jira
+
+import (
+	"context"
+	"testing"
+)
+
+func TestWaitForTask(t *testing.T) {
+	completed := AsyncTask{
+		Name: "task 1",
+		Poll: func(ctx context.Context) (*TaskState, error) {
+			return &TaskState{Status: "COMPLETE", Progress: 100, Done: true}, nil
+		},
+	}
+
+	if err := waitForTask(context.Background(), completed); err != nil {
+		t.Errorf("waitForTask of a completed task failed: %s", err)
+	}
+
+	failed := AsyncTask{
+		Name: "task 2",
+		Poll: func(ctx context.Context) (*TaskState, error) {
+			return &TaskState{Status: "FAILED", Done: true, Failed: true, Errors: []string{"project is locked", "try again"}}, nil
+		},
+	}
+
+	err := waitForTask(context.Background(), failed)
+	if err == nil || err.Error() != "task 2 ended with status FAILED: project is locked, try again" {
+		t.Errorf("waitForTask of a failed task returned %v", err)
+	}
+	if diags := taskDiagnostics(err); len(diags) != 2 || diags[0].Detail != "project is locked" {
+		t.Errorf("taskDiagnostics(%v) = %v", err, diags)
+	}
+
+	canceled := false
+	running := AsyncTask{
+		Name: "task 3",
+		Poll: func(ctx context.Context) (*TaskState, error) {
+			return &TaskState{Status: "RUNNING", Progress: 50}, nil
+		},
+		Cancel: func(ctx context.Context) error {
+			canceled = true
+			return nil
+		},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if err := waitForTask(ctx, running); err == nil {
+		t.Error("waitForTask with a canceled context succeeded")
+	}
+	if !canceled {
+		t.Error("waitForTask with a canceled context did not cancel the task")
+	}
+}